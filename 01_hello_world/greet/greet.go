@@ -0,0 +1,126 @@
+// Package greet arma saludos en varios idiomas según la hora del día.
+package greet
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrUnsupportedLocale se devuelve cuando el idioma pedido no está disponible.
+var ErrUnsupportedLocale = errors.New("greet: idioma no soportado")
+
+// phrases son las frases de un idioma.
+type phrases struct {
+	hello     string // Saludo sin nombre, como en el "¡Hola, mundo!" original
+	world     string
+	morning   string
+	afternoon string
+	evening   string
+	howAreYou string // Pregunta informal (tú)
+	howFormal string // Pregunta formal (usted)
+}
+
+var locales = map[string]phrases{
+	"es": {"Hola", "mundo", "Buenos días", "Buenas tardes", "Buenas noches", "Cómo estás", "Cómo está usted"},
+	"en": {"Hello", "world", "Good morning", "Good afternoon", "Good evening", "How are you", "How do you do"},
+	"pt": {"Olá", "mundo", "Bom dia", "Boa tarde", "Boa noite", "Tudo bem", "Como está o senhor"},
+	"fr": {"Bonjour", "le monde", "Bonjour", "Bonjour", "Bonsoir", "Comment vas-tu", "Comment allez-vous"},
+	"de": {"Hallo", "Welt", "Guten Morgen", "Guten Tag", "Guten Abend", "Wie geht es dir", "Wie geht es Ihnen"},
+}
+
+// Greeter arma saludos. El valor cero usa la hora actual y el trato informal.
+type Greeter struct {
+	// Now devuelve la hora usada para elegir el saludo. Si es nil se usa
+	// time.Now; las pruebas pueden fijar una hora concreta.
+	Now func() time.Time
+	// Formal usa el trato de usted en la pregunta de cortesía.
+	Formal bool
+}
+
+// Greet saluda a name en el idioma locale ("es", "en-US", "pt_BR", ...).
+//
+// Sin nombre devuelve el saludo clásico: "¡Hola, mundo!". Con nombre, el
+// saludo depende de la hora y va seguido de una pregunta de cortesía:
+// "¡Buenas noches, Ana! ¿Cómo estás?".
+func (g Greeter) Greet(name, locale string) (string, error) {
+	lang := normalize(locale)
+	p, ok := locales[lang]
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedLocale, locale)
+	}
+
+	// Quita los signos que el usuario haya escrito para no duplicarlos
+	name = strings.Trim(name, " ¡!¿?")
+	if name == "" {
+		return exclaim(lang, p.hello+", "+p.world), nil
+	}
+
+	now := time.Now
+	if g.Now != nil {
+		now = g.Now
+	}
+	question := p.howAreYou
+	if g.Formal {
+		question = p.howFormal
+	}
+	return exclaim(lang, timeOfDay(p, now())+", "+name) + " " + ask(lang, question), nil
+}
+
+// Greet saluda con un Greeter por defecto.
+func Greet(name, locale string) (string, error) {
+	return Greeter{}.Greet(name, locale)
+}
+
+// Locales devuelve los idiomas disponibles, ordenados.
+func Locales() []string {
+	list := make([]string, 0, len(locales))
+	for l := range locales {
+		list = append(list, l)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// normalize reduce "es-EC", "es_EC" o "ES" al código de idioma "es".
+func normalize(locale string) string {
+	lang, _, _ := strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+	return strings.ToLower(strings.TrimSpace(lang))
+}
+
+func timeOfDay(p phrases, t time.Time) string {
+	switch h := t.Hour(); {
+	case h >= 5 && h < 12:
+		return p.morning
+	case h >= 12 && h < 19:
+		return p.afternoon
+	default:
+		return p.evening
+	}
+}
+
+// exclaim y ask aplican la puntuación de cada idioma: el español abre con
+// ¡ y ¿, y el francés separa ! y ? con un espacio fino que no se corta.
+func exclaim(lang, s string) string {
+	switch lang {
+	case "es":
+		return "¡" + s + "!"
+	case "fr":
+		return s + "\u202f!"
+	default:
+		return s + "!"
+	}
+}
+
+func ask(lang, s string) string {
+	switch lang {
+	case "es":
+		return "¿" + s + "?"
+	case "fr":
+		return s + "\u202f?"
+	default:
+		return s + "?"
+	}
+}
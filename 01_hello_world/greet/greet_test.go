@@ -0,0 +1,73 @@
+package greet_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/FepDev25/gobootcamp/01_hello_world/greet"
+)
+
+func at(hour int) func() time.Time {
+	return func() time.Time { return time.Date(2024, 1, 1, hour, 0, 0, 0, time.UTC) }
+}
+
+func TestGreet(t *testing.T) {
+	tests := []struct {
+		locale, name string
+		hour         int
+		formal       bool
+		want         string
+	}{
+		{"es", "", 9, false, "¡Hola, mundo!"},
+		{"es", "Ana", 9, false, "¡Buenos días, Ana! ¿Cómo estás?"},
+		{"es", "Ana", 15, false, "¡Buenas tardes, Ana! ¿Cómo estás?"},
+		{"es", "Ana", 22, true, "¡Buenas noches, Ana! ¿Cómo está usted?"},
+		{"es-EC", "¡Ana!", 3, false, "¡Buenas noches, Ana! ¿Cómo estás?"},
+		{"en", "", 9, false, "Hello, world!"},
+		{"en-US", "Bob", 9, false, "Good morning, Bob! How are you?"},
+		{"en", "Bob", 19, true, "Good evening, Bob! How do you do?"},
+		{"pt", "", 9, false, "Olá, mundo!"},
+		{"pt_BR", "João", 12, false, "Boa tarde, João! Tudo bem?"},
+		{"fr", "", 9, false, "Bonjour, le monde\u202f!"},
+		{"FR", "Marie", 20, true, "Bonsoir, Marie\u202f! Comment allez-vous\u202f?"},
+		{"de", "", 9, false, "Hallo, Welt!"},
+		{"de-AT", "Lukas", 5, false, "Guten Morgen, Lukas! Wie geht es dir?"},
+	}
+	for _, tt := range tests {
+		g := greet.Greeter{Now: at(tt.hour), Formal: tt.formal}
+		got, err := g.Greet(tt.name, tt.locale)
+		if err != nil {
+			t.Errorf("Greet(%q, %q): %v", tt.name, tt.locale, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Greet(%q, %q) a las %d = %q, se esperaba %q", tt.name, tt.locale, tt.hour, got, tt.want)
+		}
+	}
+}
+
+func TestGreetUnsupportedLocale(t *testing.T) {
+	for _, locale := range []string{"", "it", "zh-CN", "español"} {
+		got, err := greet.Greet("Ana", locale)
+		if !errors.Is(err, greet.ErrUnsupportedLocale) {
+			t.Errorf("Greet(%q): error %v, se esperaba ErrUnsupportedLocale", locale, err)
+		}
+		if got != "" {
+			t.Errorf("Greet(%q) = %q, se esperaba una cadena vacía", locale, got)
+		}
+	}
+}
+
+func TestLocales(t *testing.T) {
+	want := []string{"de", "en", "es", "fr", "pt"}
+	if got := greet.Locales(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Locales() = %q, se esperaba %q", got, want)
+	}
+	for _, locale := range want {
+		if _, err := greet.Greet("", locale); err != nil {
+			t.Errorf("Greet(\"\", %q): %v", locale, err)
+		}
+	}
+}
@@ -1,7 +1,25 @@
 package main
 
-import "fmt"
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
 
+	"github.com/FepDev25/gobootcamp/01_hello_world/greet"
+)
+
+// Sin opciones imprime "¡Hola, mundo!". Ej: go run hello.go --name Ana --lang en --formal
 func main() {
-	fmt.Println("¡Hola, mundo!")
+	name := flag.String("name", "", "nombre de la persona a saludar")
+	lang := flag.String("lang", "es", "idioma: "+strings.Join(greet.Locales(), ", "))
+	formal := flag.Bool("formal", false, "usar el trato formal (usted)")
+	flag.Parse()
+
+	saludo, err := greet.Greeter{Formal: *formal}.Greet(*name, *lang)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+	fmt.Println(saludo)
 }
@@ -0,0 +1,155 @@
+// Package bulk descarga muchas publicaciones de JSONPlaceholder en paralelo,
+// con un límite de goroutines y de peticiones por segundo.
+package bulk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/FepDev25/gobootcamp/02_basics/01_imports/jsonplaceholder"
+)
+
+// Result es una línea de la salida NDJSON.
+type Result struct {
+	ID    int                   `json:"id"`
+	Post  *jsonplaceholder.Post `json:"post,omitempty"`
+	Error string                `json:"error,omitempty"`
+}
+
+// Fetcher descarga rangos de publicaciones.
+type Fetcher struct {
+	Client   *jsonplaceholder.Client
+	Workers  int       // Peticiones simultáneas; menor que 1 usa 1
+	Limiter  *Limiter  // nil no limita la tasa
+	Progress io.Writer // Si no es nil recibe el avance, ej. os.Stderr
+}
+
+// Run descarga los IDs de from a to (inclusive) y escribe un Result por
+// línea en out, en el orden en que terminan.
+//
+// Un 404 queda registrado en su Result y no detiene la descarga. Cualquier
+// otro error es fatal: cancela las peticiones en curso y Run lo devuelve.
+func (f *Fetcher) Run(ctx context.Context, from, to int, out io.Writer) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	ids := make(chan int)
+	results := make(chan Result)
+
+	go func() {
+		defer close(ids)
+		for id := from; id <= to; id++ {
+			select {
+			case ids <- id:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for range max(f.Workers, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range ids {
+				r, err := f.fetch(ctx, id)
+				if err != nil {
+					cancel(fmt.Errorf("post %d: %w", id, err))
+					return
+				}
+				select {
+				case results <- r:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	enc := json.NewEncoder(out)
+	p := progress{w: f.Progress, total: to - from + 1, start: time.Now()}
+	for r := range results {
+		if err := enc.Encode(r); err != nil {
+			cancel(err)
+			break
+		}
+		p.tick(r.Error != "")
+	}
+	p.done()
+
+	// Drena los resultados pendientes si se salió antes del bucle
+	for range results {
+	}
+	if err := context.Cause(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+	return ctx.Err()
+}
+
+func (f *Fetcher) fetch(ctx context.Context, id int) (Result, error) {
+	if f.Limiter != nil {
+		if err := f.Limiter.Wait(ctx); err != nil {
+			return Result{}, err
+		}
+	}
+	post, err := f.Client.GetPost(ctx, id)
+	if errors.Is(err, jsonplaceholder.ErrNotFound) {
+		return Result{ID: id, Error: err.Error()}, nil
+	}
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{ID: id, Post: post}, nil
+}
+
+// progress muestra el avance en una sola línea, como mucho diez veces por
+// segundo.
+type progress struct {
+	w        io.Writer
+	total    int
+	count    int
+	failed   int
+	start    time.Time
+	lastShow time.Time
+}
+
+func (p *progress) tick(failed bool) {
+	p.count++
+	if failed {
+		p.failed++
+	}
+	if p.w != nil && time.Since(p.lastShow) >= 100*time.Millisecond {
+		p.show()
+	}
+}
+
+func (p *progress) done() {
+	if p.w != nil {
+		p.show()
+		fmt.Fprintln(p.w)
+	}
+}
+
+func (p *progress) show() {
+	p.lastShow = time.Now()
+	elapsed := time.Since(p.start).Seconds()
+	rate, percent := 0.0, 100.0
+	if elapsed > 0 {
+		rate = float64(p.count) / elapsed
+	}
+	if p.total > 0 {
+		percent = 100 * float64(p.count) / float64(p.total)
+	}
+	fmt.Fprintf(p.w, "\r%d/%d (%.0f%%) %d no encontrados, %.1f req/s",
+		p.count, p.total, percent, p.failed, rate)
+}
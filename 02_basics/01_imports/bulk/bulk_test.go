@@ -0,0 +1,160 @@
+package bulk
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	red "net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/FepDev25/gobootcamp/02_basics/01_imports/jsonplaceholder"
+)
+
+// server simula /posts/{id}, cuenta las peticiones simultáneas y responde
+// 404 para los IDs de missing.
+type server struct {
+	delay   time.Duration
+	missing map[int]bool
+
+	mu       sync.Mutex
+	inFlight int
+	peak     int
+	requests int
+}
+
+func (s *server) ServeHTTP(w red.ResponseWriter, r *red.Request) {
+	s.mu.Lock()
+	s.inFlight++
+	s.requests++
+	s.peak = max(s.peak, s.inFlight)
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.inFlight--
+		s.mu.Unlock()
+	}()
+
+	time.Sleep(s.delay)
+	id, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/posts/"))
+	if err != nil {
+		red.Error(w, "ruta inválida", red.StatusBadRequest)
+		return
+	}
+	if id == 0 {
+		red.Error(w, "fallo", red.StatusInternalServerError)
+		return
+	}
+	if s.missing[id] {
+		red.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"userId":1,"id":%d,"title":"post %d","body":""}`, id, id)
+}
+
+func newFetcher(t *testing.T, s *server, workers int) *Fetcher {
+	t.Helper()
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+	return &Fetcher{
+		Client:  &jsonplaceholder.Client{BaseURL: srv.URL, HTTPClient: srv.Client()},
+		Workers: workers,
+	}
+}
+
+func TestRunRespectsWorkers(t *testing.T) {
+	for _, workers := range []int{1, 3, 8} {
+		t.Run(strconv.Itoa(workers), func(t *testing.T) {
+			s := &server{delay: 20 * time.Millisecond, missing: map[int]bool{7: true}}
+			f := newFetcher(t, s, workers)
+
+			var out bytes.Buffer
+			if err := f.Run(context.Background(), 1, 24, &out); err != nil {
+				t.Fatal(err)
+			}
+			if s.peak > workers {
+				t.Errorf("%d peticiones simultáneas, el límite era %d", s.peak, workers)
+			}
+			// Con 24 IDs y 20 ms por petición los workers llegan a solaparse
+			if s.peak < workers {
+				t.Errorf("solo %d peticiones simultáneas con %d workers", s.peak, workers)
+			}
+
+			seen := make(map[int]bool)
+			scanner := bufio.NewScanner(&out)
+			for scanner.Scan() {
+				var r Result
+				if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+					t.Fatal(err)
+				}
+				seen[r.ID] = true
+				if (r.Error != "") != (r.ID == 7) || (r.Post == nil) != (r.ID == 7) {
+					t.Errorf("resultado inesperado: %+v", r)
+				}
+			}
+			if len(seen) != 24 || s.requests != 24 {
+				t.Errorf("%d resultados, %d peticiones; se esperaban 24", len(seen), s.requests)
+			}
+		})
+	}
+}
+
+func TestRunStopsOnError(t *testing.T) {
+	s := &server{}
+	f := newFetcher(t, s, 2)
+	// El ID 0 responde 500
+	if err := f.Run(context.Background(), 0, 100, &bytes.Buffer{}); err == nil || !strings.Contains(err.Error(), "post 0") {
+		t.Errorf("Run = %v, se esperaba el error del post 0", err)
+	}
+	if s.requests == 101 {
+		t.Error("se descargaron todos los IDs a pesar del error")
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	l := NewLimiter(50, 2)
+	ctx := context.Background()
+	start := time.Now()
+	for range 7 {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Dos salen de golpe y las cinco restantes esperan 20 ms cada una
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("7 fichas en %v; con 50/s y ráfaga de 2 deberían tardar ~100ms", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	slow := NewLimiter(0.001, 1)
+	slow.Wait(context.Background())
+	if err := slow.Wait(ctx); err != context.Canceled {
+		t.Errorf("Wait con el contexto cancelado = %v", err)
+	}
+}
+
+func TestLimiterUnlimited(t *testing.T) {
+	for _, rate := range []float64{0, -1} {
+		l := NewLimiter(rate, 1)
+		done := make(chan struct{})
+		go func() {
+			for range 1000 {
+				l.Wait(context.Background())
+			}
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatalf("NewLimiter(%v, 1) limita la tasa", rate)
+		}
+	}
+}
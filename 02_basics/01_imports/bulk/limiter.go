@@ -0,0 +1,61 @@
+package bulk
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter es un token bucket: se llena a Rate fichas por segundo hasta un
+// máximo de Burst, y cada petición consume una ficha.
+type Limiter struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter crea un limitador que empieza lleno. burst menor que 1 se
+// trata como 1, y rate menor o igual que 0 no limita.
+func NewLimiter(rate float64, burst int) *Limiter {
+	b := float64(max(burst, 1))
+	return &Limiter{rate: rate, burst: b, tokens: b, last: time.Now()}
+}
+
+// Wait bloquea hasta que haya una ficha disponible o se cancele ctx.
+func (l *Limiter) Wait(ctx context.Context) error {
+	for {
+		wait := l.reserve()
+		if wait == 0 {
+			return nil
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve toma una ficha si hay y devuelve 0, o devuelve cuánto falta para
+// que se genere la siguiente.
+func (l *Limiter) reserve() time.Duration {
+	if l.rate <= 0 {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
@@ -0,0 +1,274 @@
+// Package cassette graba y reproduce tráfico HTTP para que las lecciones y
+// sus pruebas funcionen sin red.
+//
+// En modo Record cada petición se envía de verdad y el par petición/respuesta
+// se guarda en un archivo JSON (el "cassette"). En modo Replay las respuestas
+// salen del archivo y una petición que no coincide con ninguna grabada es un
+// error.
+package cassette
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	red "net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"unicode/utf8"
+)
+
+// Mode indica si el cassette graba o reproduce.
+type Mode int
+
+const (
+	Replay Mode = iota
+	Record
+)
+
+// ErrNoInteraction indica que en modo Replay no hay una respuesta grabada
+// para la petición.
+var ErrNoInteraction = errors.New("cassette: no hay una interacción grabada para la petición")
+
+// Redacted reemplaza el valor de los encabezados sensibles.
+const Redacted = "[REDACTED]"
+
+// DefaultRedactHeaders son los encabezados que nunca se guardan.
+var DefaultRedactHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", "X-Api-Key"}
+
+// Matcher elige qué partes de la petición deben coincidir con la grabada.
+type Matcher struct {
+	Method bool
+	URL    bool
+	Body   bool // Compara el hash SHA-256 del cuerpo
+}
+
+// DefaultMatcher compara el método y la URL.
+var DefaultMatcher = Matcher{Method: true, URL: true}
+
+// Request es una petición grabada.
+type Request struct {
+	Method   string     `json:"method"`
+	URL      string     `json:"url"`
+	Header   red.Header `json:"header,omitempty"`
+	Body     string     `json:"body,omitempty"`
+	BodyHash string     `json:"body_sha256,omitempty"`
+}
+
+// Response es una respuesta grabada. Los cuerpos que no son UTF-8 válido se
+// guardan en base64.
+type Response struct {
+	StatusCode int        `json:"status_code"`
+	Header     red.Header `json:"header,omitempty"`
+	Body       string     `json:"body,omitempty"`
+	BodyBase64 string     `json:"body_base64,omitempty"`
+}
+
+// Interaction es un par petición/respuesta.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Transport es un red.RoundTripper que graba o reproduce un cassette.
+type Transport struct {
+	Path  string
+	Mode  Mode
+	Match Matcher
+	Base  red.RoundTripper // Transporte real en modo Record; nil usa red.DefaultTransport
+
+	// RedactHeaders se suma a DefaultRedactHeaders. Solo se ocultan
+	// encabezados: el cuerpo de la petición se guarda tal cual, con las
+	// contraseñas o tokens que lleve, salvo que RedactBody sea true. En ese
+	// caso se guarda solo su hash, que basta para Matcher.Body.
+	RedactHeaders []string
+	RedactBody    bool
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// New abre el cassette de path. En modo Replay el archivo debe existir; en
+// modo Record se empieza de cero y el archivo se reescribe con cada petición.
+func New(path string, mode Mode) (*Transport, error) {
+	t := &Transport{Path: path, Mode: mode, Match: DefaultMatcher}
+	if mode == Record {
+		return t, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &t.interactions); err != nil {
+		return nil, fmt.Errorf("cassette %s: %w", path, err)
+	}
+	t.used = make([]bool, len(t.interactions))
+	return t, nil
+}
+
+// RoundTrip implementa red.RoundTripper.
+func (t *Transport) RoundTrip(req *red.Request) (*red.Response, error) {
+	req, body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	recorded := Request{
+		Method:   req.Method,
+		URL:      req.URL.String(),
+		Header:   t.redact(req.Header),
+		Body:     string(body),
+		BodyHash: hashOf(body),
+	}
+	if t.RedactBody {
+		recorded.Body = ""
+	}
+
+	if t.Mode == Record {
+		return t.record(req, recorded)
+	}
+	return t.replay(req, recorded)
+}
+
+func (t *Transport) replay(req *red.Request, r Request) (*red.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for i, in := range t.interactions {
+		if t.used[i] || !t.matches(in.Request, r) {
+			continue
+		}
+		t.used[i] = true
+
+		body := []byte(in.Response.Body)
+		if in.Response.BodyBase64 != "" {
+			decoded, err := base64.StdEncoding.DecodeString(in.Response.BodyBase64)
+			if err != nil {
+				return nil, fmt.Errorf("cassette %s: interacción %d: %w", t.Path, i+1, err)
+			}
+			body = decoded
+		}
+		return &red.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, red.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        in.Response.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, r.Method, r.URL)
+}
+
+func (t *Transport) record(req *red.Request, r Request) (*red.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = red.DefaultTransport
+	}
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	recorded := Response{StatusCode: resp.StatusCode, Header: t.redact(resp.Header)}
+	if utf8.Valid(body) {
+		recorded.Body = string(body)
+	} else {
+		recorded.BodyBase64 = base64.StdEncoding.EncodeToString(body)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.interactions = append(t.interactions, Interaction{Request: r, Response: recorded})
+	return resp, t.save()
+}
+
+// save escribe el cassette en un archivo temporal y lo renombra, para no
+// dejar un archivo a medio escribir.
+func (t *Transport) save() error {
+	data, err := json.MarshalIndent(t.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(t.Path), 0o755); err != nil {
+		return err
+	}
+	tmp := t.Path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, t.Path)
+}
+
+func (t *Transport) matches(recorded, r Request) bool {
+	m := t.Match
+	if m == (Matcher{}) {
+		m = DefaultMatcher
+	}
+	if m.Method && recorded.Method != r.Method {
+		return false
+	}
+	if m.URL && recorded.URL != r.URL {
+		return false
+	}
+	if m.Body && recorded.BodyHash != r.BodyHash {
+		return false
+	}
+	return true
+}
+
+// redact copia los encabezados reemplazando los sensibles.
+func (t *Transport) redact(h red.Header) red.Header {
+	if len(h) == 0 {
+		return nil
+	}
+	out := h.Clone()
+	for _, list := range [][]string{DefaultRedactHeaders, t.RedactHeaders} {
+		for _, name := range list {
+			if _, ok := out[red.CanonicalHeaderKey(name)]; ok {
+				out[red.CanonicalHeaderKey(name)] = []string{Redacted}
+			}
+		}
+	}
+	return out
+}
+
+// readBody lee el cuerpo de la petición. Como un RoundTripper no debe
+// modificar la petición que recibe, devuelve una copia con el cuerpo listo
+// para volver a leerse.
+func readBody(req *red.Request) (*red.Request, []byte, error) {
+	if req.Body == nil || req.Body == red.NoBody {
+		return req, nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	clone := req.Clone(req.Context())
+	clone.Body = io.NopCloser(bytes.NewReader(body))
+	return clone, body, nil
+}
+
+func hashOf(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,217 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	red "net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// server responde con el método, la ruta y el cuerpo de la petición, y
+// cuenta cuántas recibió.
+func server(t *testing.T) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+	var hits atomic.Int64
+	srv := httptest.NewServer(red.HandlerFunc(func(w red.ResponseWriter, r *red.Request) {
+		hits.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Set-Cookie", "sesion=secreta")
+		w.Header().Set("X-Origen", "prueba")
+		if r.URL.Path == "/binario" {
+			w.Write([]byte{0xff, 0xfe, 0x00})
+			return
+		}
+		fmt.Fprintf(w, "%s %s %s", r.Method, r.URL.Path, body)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &hits
+}
+
+func do(t *testing.T, c *red.Client, method, url, body string) (string, error) {
+	t.Helper()
+	req, err := red.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer token-secreto")
+	req.Header.Set("X-Secreto", "clave")
+	resp, err := c.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	return string(data), err
+}
+
+func record(t *testing.T, srv *httptest.Server, path string, configure func(*Transport), requests ...[3]string) {
+	t.Helper()
+	tr, err := New(path, Record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr.Base = srv.Client().Transport
+	if configure != nil {
+		configure(tr)
+	}
+	c := &red.Client{Transport: tr}
+	for _, r := range requests {
+		if _, err := do(t, c, r[0], srv.URL+r[1], r[2]); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func replay(t *testing.T, path string, m Matcher) *red.Client {
+	t.Helper()
+	tr, err := New(path, Replay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr.Match = m
+	return &red.Client{Transport: tr}
+}
+
+func TestRecordReplay(t *testing.T) {
+	srv, hits := server(t)
+	path := filepath.Join(t.TempDir(), "sub", "cassette.json")
+	record(t, srv, path, nil,
+		[3]string{"GET", "/posts/1", ""},
+		[3]string{"POST", "/posts", `{"title":"hola"}`},
+		[3]string{"GET", "/binario", ""},
+	)
+	if hits.Load() != 3 {
+		t.Fatalf("se grabaron %d peticiones, se esperaban 3", hits.Load())
+	}
+
+	c := replay(t, path, Matcher{})
+	tests := []struct{ method, path, body, want string }{
+		{"POST", "/posts", `{"title":"hola"}`, `POST /posts {"title":"hola"}`},
+		{"GET", "/posts/1", "", "GET /posts/1 "},
+		{"GET", "/binario", "", "\xff\xfe\x00"},
+	}
+	for _, tt := range tests {
+		got, err := do(t, c, tt.method, srv.URL+tt.path, tt.body)
+		if err != nil {
+			t.Errorf("%s %s: %v", tt.method, tt.path, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s %s = %q, se esperaba %q", tt.method, tt.path, got, tt.want)
+		}
+	}
+	if hits.Load() != 3 {
+		t.Errorf("la reproducción hizo %d peticiones reales", hits.Load()-3)
+	}
+}
+
+func TestReplayNoInteraction(t *testing.T) {
+	srv, _ := server(t)
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	record(t, srv, path, nil, [3]string{"GET", "/posts/1", ""})
+
+	c := replay(t, path, Matcher{})
+	if _, err := do(t, c, "GET", srv.URL+"/posts/2", ""); !errors.Is(err, ErrNoInteraction) {
+		t.Errorf("URL distinta: error %v, se esperaba ErrNoInteraction", err)
+	}
+	if _, err := do(t, c, "DELETE", srv.URL+"/posts/1", ""); !errors.Is(err, ErrNoInteraction) {
+		t.Errorf("método distinto: error %v, se esperaba ErrNoInteraction", err)
+	}
+	// Cada interacción se reproduce una sola vez
+	if _, err := do(t, c, "GET", srv.URL+"/posts/1", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := do(t, c, "GET", srv.URL+"/posts/1", ""); !errors.Is(err, ErrNoInteraction) {
+		t.Errorf("segunda vez: error %v, se esperaba ErrNoInteraction", err)
+	}
+
+	if _, err := New(filepath.Join(t.TempDir(), "no-existe.json"), Replay); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("cassette inexistente: error %v, se esperaba os.ErrNotExist", err)
+	}
+}
+
+func TestRedaction(t *testing.T) {
+	srv, _ := server(t)
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	record(t, srv, path, func(tr *Transport) {
+		tr.RedactHeaders = []string{"x-secreto"}
+	}, [3]string{"POST", "/login", "password=hunter2"})
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"token-secreto", "clave", "sesion=secreta"} {
+		if bytes.Contains(data, []byte(secret)) {
+			t.Errorf("el cassette contiene %q:\n%s", secret, data)
+		}
+	}
+	if n := bytes.Count(data, []byte(Redacted)); n != 3 {
+		t.Errorf("%d valores ocultos, se esperaban 3 (Authorization, X-Secreto, Set-Cookie)", n)
+	}
+	if !bytes.Contains(data, []byte("prueba")) {
+		t.Error("se ocultó X-Origen, que no es sensible")
+	}
+	// Sin RedactBody el cuerpo se guarda tal cual
+	if !bytes.Contains(data, []byte("password=hunter2")) {
+		t.Error("se esperaba el cuerpo de la petición en el cassette")
+	}
+
+	record(t, srv, path, func(tr *Transport) { tr.RedactBody = true },
+		[3]string{"POST", "/login", "password=hunter2"})
+	data, err = os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var saved []Interaction
+	if err := json.Unmarshal(data, &saved); err != nil {
+		t.Fatal(err)
+	}
+	if req := saved[0].Request; req.Body != "" || req.BodyHash == "" {
+		t.Errorf("con RedactBody se grabó cuerpo %q y hash %q, se esperaba solo el hash", req.Body, req.BodyHash)
+	}
+	c := replay(t, path, Matcher{Method: true, URL: true, Body: true})
+	if _, err := do(t, c, "POST", srv.URL+"/login", "password=hunter2"); err != nil {
+		t.Errorf("con RedactBody el hash debe bastar para reproducir: %v", err)
+	}
+}
+
+func TestMatchBody(t *testing.T) {
+	srv, _ := server(t)
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	record(t, srv, path, nil,
+		[3]string{"POST", "/posts", "uno"},
+		[3]string{"POST", "/posts", "dos"},
+	)
+
+	// Comparando el cuerpo, el orden de las peticiones no importa
+	c := replay(t, path, Matcher{Method: true, URL: true, Body: true})
+	for _, body := range []string{"dos", "uno"} {
+		got, err := do(t, c, "POST", srv.URL+"/posts", body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "POST /posts " + body; got != want {
+			t.Errorf("cuerpo %q: respuesta %q, se esperaba %q", body, got, want)
+		}
+	}
+
+	c = replay(t, path, Matcher{Method: true, URL: true, Body: true})
+	if _, err := do(t, c, "POST", srv.URL+"/posts", "tres"); !errors.Is(err, ErrNoInteraction) {
+		t.Errorf("cuerpo no grabado: error %v, se esperaba ErrNoInteraction", err)
+	}
+
+	// Sin comparar el cuerpo se entregan en el orden grabado
+	c = replay(t, path, Matcher{})
+	if got, _ := do(t, c, "POST", srv.URL+"/posts", "dos"); got != "POST /posts uno" {
+		t.Errorf("sin Body la primera respuesta es %q, se esperaba la de \"uno\"", got)
+	}
+}
@@ -0,0 +1,79 @@
+// bulkfetch descarga las publicaciones 1..N de JSONPlaceholder en paralelo y
+// las escribe como NDJSON, una por línea.
+//
+// Ej: go run ./02_basics/01_imports/cmd/bulkfetch -n 100 -workers 8 -rate 20 > posts.ndjson
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	red "net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/FepDev25/gobootcamp/02_basics/01_imports/bulk"
+	"github.com/FepDev25/gobootcamp/02_basics/01_imports/jsonplaceholder"
+	"github.com/FepDev25/gobootcamp/02_basics/01_imports/retry"
+)
+
+func main() {
+	n := flag.Int("n", 100, "descarga los IDs de 1 a n")
+	workers := flag.Int("workers", 8, "peticiones simultáneas")
+	rate := flag.Float64("rate", 10, "peticiones por segundo (0 sin límite)")
+	burst := flag.Int("burst", 5, "peticiones que pueden salir de golpe")
+	baseURL := flag.String("base-url", jsonplaceholder.DefaultBaseURL, "dirección de la API")
+	output := flag.String("o", "", "archivo NDJSON de salida (por defecto stdout)")
+	flag.Parse()
+
+	fetcher := &bulk.Fetcher{
+		Client: &jsonplaceholder.Client{
+			BaseURL: *baseURL,
+			HTTPClient: &red.Client{
+				Timeout:   30 * time.Second,
+				Transport: &retry.Transport{},
+			},
+		},
+		Workers:  *workers,
+		Progress: os.Stderr,
+	}
+	if *rate > 0 {
+		fetcher.Limiter = bulk.NewLimiter(*rate, *burst)
+	}
+
+	if err := run(fetcher, *n, *output); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+// run está separado de main para que los defer se ejecuten antes de
+// os.Exit.
+func run(fetcher *bulk.Fetcher, n int, output string) (err error) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var out io.Writer = os.Stdout
+	if output != "" {
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+		}()
+		out = f
+	}
+	w := bufio.NewWriter(out)
+
+	err = fetcher.Run(ctx, 1, n, w)
+	if ferr := w.Flush(); err == nil {
+		err = ferr
+	}
+	return err
+}
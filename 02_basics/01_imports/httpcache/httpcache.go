@@ -0,0 +1,210 @@
+// Package httpcache evita descargar de nuevo los recursos que no cambiaron.
+//
+// Las respuestas a GET se guardan según Cache-Control: mientras sigan
+// frescas (max-age) se sirven sin tocar la red, y al vencer se revalidan con
+// If-None-Match o If-Modified-Since; un 304 se responde con la copia
+// guardada. La petición puede exigir una revalidación con Cache-Control:
+// no-cache o max-age=0. No se tiene en cuenta Vary, así que no sirve para recursos que
+// cambian según los encabezados de la petición.
+package httpcache
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	red "net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Transport es un red.RoundTripper con caché.
+type Transport struct {
+	Base  red.RoundTripper // nil usa red.DefaultTransport
+	Store Store            // nil usa un MemoryStore creado al primer uso
+
+	hits          atomic.Int64
+	misses        atomic.Int64
+	revalidations atomic.Int64
+	initStore     atomic.Pointer[MemoryStore]
+}
+
+// Stats son los contadores de la caché.
+type Stats struct {
+	Hits          int64 // Respuestas servidas desde la caché, incluidas las revalidadas
+	Misses        int64 // Respuestas descargadas completas
+	Revalidations int64 // Respuestas 304 recibidas
+}
+
+func (s Stats) String() string {
+	return fmt.Sprintf("%d aciertos (%d revalidados), %d fallos", s.Hits, s.Revalidations, s.Misses)
+}
+
+// Stats devuelve los contadores actuales.
+func (t *Transport) Stats() Stats {
+	return Stats{Hits: t.hits.Load(), Misses: t.misses.Load(), Revalidations: t.revalidations.Load()}
+}
+
+// RoundTrip implementa red.RoundTripper.
+func (t *Transport) RoundTrip(req *red.Request) (*red.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = red.DefaultTransport
+	}
+	reqCC := directives(req.Header)
+	if req.Method != red.MethodGet || reqCC.has("no-store") {
+		return base.RoundTrip(req)
+	}
+
+	store := t.store()
+	key := req.URL.String()
+	entry, ok := store.Get(key)
+	if ok && entry.fresh(time.Now()) && reqCC.accepts(entry, time.Now()) {
+		t.hits.Add(1)
+		return entry.response(req), nil
+	}
+
+	outgoing := req
+	if ok {
+		if etag, lastModified := entry.Header.Get("ETag"), entry.Header.Get("Last-Modified"); etag != "" || lastModified != "" {
+			outgoing = req.Clone(req.Context())
+			if etag != "" {
+				outgoing.Header.Set("If-None-Match", etag)
+			}
+			if lastModified != "" {
+				outgoing.Header.Set("If-Modified-Since", lastModified)
+			}
+		}
+	}
+
+	resp, err := base.RoundTrip(outgoing)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok && resp.StatusCode == red.StatusNotModified {
+		resp.Body.Close()
+		// El 304 puede traer un Cache-Control o ETag nuevos
+		updated := *entry
+		updated.Header = entry.Header.Clone()
+		for k, v := range resp.Header {
+			updated.Header[k] = v
+		}
+		updated.Stored = time.Now()
+		store.Set(key, &updated)
+		t.revalidations.Add(1)
+		t.hits.Add(1)
+		return updated.response(req), nil
+	}
+
+	t.misses.Add(1)
+	if !storable(resp) {
+		store.Delete(key)
+		return resp, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	store.Set(key, &Entry{StatusCode: resp.StatusCode, Header: resp.Header.Clone(), Body: body, Stored: time.Now()})
+	return resp, nil
+}
+
+func (t *Transport) store() Store {
+	if t.Store != nil {
+		return t.Store
+	}
+	if s := t.initStore.Load(); s != nil {
+		return s
+	}
+	t.initStore.CompareAndSwap(nil, NewMemoryStore())
+	return t.initStore.Load()
+}
+
+// storable indica si vale la pena guardar la respuesta: un 200 que no
+// prohíbe guardarse y que trae max-age o un validador para revalidarla.
+func storable(resp *red.Response) bool {
+	if resp.StatusCode != red.StatusOK {
+		return false
+	}
+	cc := directives(resp.Header)
+	if cc.has("no-store") || cc.has("private") {
+		return false
+	}
+	_, hasMaxAge := cc.maxAge()
+	return hasMaxAge || resp.Header.Get("ETag") != "" || resp.Header.Get("Last-Modified") != ""
+}
+
+// fresh indica si la entrada puede servirse sin consultar al servidor.
+func (e *Entry) fresh(now time.Time) bool {
+	cc := directives(e.Header)
+	if cc.has("no-cache") {
+		return false
+	}
+	maxAge, ok := cc.maxAge()
+	return ok && now.Sub(e.Stored) < maxAge
+}
+
+// accepts indica si la petición acepta una entrada fresca sin revalidarla:
+// no-cache la rechaza siempre y max-age=N si tiene más de N segundos.
+func (cc cacheControl) accepts(e *Entry, now time.Time) bool {
+	if cc.has("no-cache") {
+		return false
+	}
+	if maxAge, ok := cc.maxAge(); ok {
+		return now.Sub(e.Stored) < maxAge
+	}
+	return true
+}
+
+func (e *Entry) response(req *red.Request) *red.Response {
+	header := e.Header.Clone()
+	header.Set("X-Cache", "HIT")
+	return &red.Response{
+		Status:        fmt.Sprintf("%d %s", e.StatusCode, red.StatusText(e.StatusCode)),
+		StatusCode:    e.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// cacheControl son las directivas de un encabezado Cache-Control.
+type cacheControl map[string]string
+
+func directives(h red.Header) cacheControl {
+	cc := make(cacheControl)
+	for _, line := range h.Values("Cache-Control") {
+		for _, part := range strings.Split(line, ",") {
+			name, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+			if name != "" {
+				cc[strings.ToLower(name)] = strings.Trim(value, `"`)
+			}
+		}
+	}
+	return cc
+}
+
+func (cc cacheControl) has(name string) bool {
+	_, ok := cc[name]
+	return ok
+}
+
+func (cc cacheControl) maxAge() (time.Duration, bool) {
+	value, ok := cc["max-age"]
+	if !ok {
+		return 0, false
+	}
+	secs, err := strconv.Atoi(value)
+	if err != nil || secs < 0 {
+		return 0, false
+	}
+	return time.Duration(secs) * time.Second, true
+}
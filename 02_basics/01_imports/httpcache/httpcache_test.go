@@ -0,0 +1,152 @@
+package httpcache
+
+import (
+	"fmt"
+	"io"
+	red "net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// origin responde con un ETag y 304 cuando If-None-Match coincide.
+type origin struct {
+	cacheControl string
+	requests     atomic.Int64
+	notModified  atomic.Int64
+}
+
+func (o *origin) ServeHTTP(w red.ResponseWriter, r *red.Request) {
+	o.requests.Add(1)
+	const etag = `"v1"`
+	w.Header().Set("ETag", etag)
+	if o.cacheControl != "" {
+		w.Header().Set("Cache-Control", o.cacheControl)
+	}
+	if r.Header.Get("If-None-Match") == etag {
+		o.notModified.Add(1)
+		w.WriteHeader(red.StatusNotModified)
+		return
+	}
+	fmt.Fprint(w, "hola")
+}
+
+func newClient(t *testing.T, o *origin, store Store) (*red.Client, *Transport, string) {
+	t.Helper()
+	srv := httptest.NewServer(o)
+	t.Cleanup(srv.Close)
+	tr := &Transport{Base: srv.Client().Transport, Store: store}
+	return &red.Client{Transport: tr}, tr, srv.URL
+}
+
+func get(t *testing.T, c *red.Client, url string, header ...string) *red.Response {
+	t.Helper()
+	req, err := red.NewRequest(red.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i+1 < len(header); i += 2 {
+		req.Header.Set(header[i], header[i+1])
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != red.StatusOK || string(body) != "hola" {
+		t.Fatalf("respuesta %d %q", resp.StatusCode, body)
+	}
+	return resp
+}
+
+func TestRevalidation(t *testing.T) {
+	o := &origin{cacheControl: "no-cache"}
+	c, tr, url := newClient(t, o, nil)
+
+	if resp := get(t, c, url); resp.Header.Get("X-Cache") != "" {
+		t.Error("la primera respuesta no debería venir de la caché")
+	}
+	// La respuesta pide revalidar siempre: el servidor responde 304 y el
+	// cliente recibe el 200 guardado
+	if resp := get(t, c, url); resp.Header.Get("X-Cache") != "HIT" {
+		t.Error("la respuesta revalidada debería venir de la caché")
+	}
+	if got, want := tr.Stats(), (Stats{Hits: 1, Misses: 1, Revalidations: 1}); got != want {
+		t.Errorf("Stats = %+v, se esperaba %+v", got, want)
+	}
+	if o.requests.Load() != 2 || o.notModified.Load() != 1 {
+		t.Errorf("el servidor recibió %d peticiones y respondió %d 304", o.requests.Load(), o.notModified.Load())
+	}
+}
+
+func TestFresh(t *testing.T) {
+	o := &origin{cacheControl: "max-age=60"}
+	c, tr, url := newClient(t, o, nil)
+
+	get(t, c, url)
+	get(t, c, url)
+	if o.requests.Load() != 1 {
+		t.Errorf("una respuesta fresca no debería pedirse de nuevo: %d peticiones", o.requests.Load())
+	}
+
+	// La petición puede exigir revalidar
+	get(t, c, url, "Cache-Control", "no-cache")
+	get(t, c, url, "Cache-Control", "max-age=0")
+	if o.requests.Load() != 3 || o.notModified.Load() != 2 {
+		t.Errorf("no-cache y max-age=0: %d peticiones, %d 304", o.requests.Load(), o.notModified.Load())
+	}
+	// max-age más largo que la edad de la entrada la acepta
+	get(t, c, url, "Cache-Control", "max-age=3600")
+	if o.requests.Load() != 3 {
+		t.Errorf("max-age=3600 revalidó una entrada recién guardada")
+	}
+	if got, want := tr.Stats(), (Stats{Hits: 4, Misses: 1, Revalidations: 2}); got != want {
+		t.Errorf("Stats = %+v, se esperaba %+v", got, want)
+	}
+}
+
+func TestNotStored(t *testing.T) {
+	for _, cc := range []string{"no-store", "private, max-age=60"} {
+		o := &origin{cacheControl: cc}
+		c, _, url := newClient(t, o, nil)
+		get(t, c, url)
+		get(t, c, url)
+		if o.notModified.Load() != 0 || o.requests.Load() != 2 {
+			t.Errorf("%s: %d peticiones, %d 304; no debería guardarse", cc, o.requests.Load(), o.notModified.Load())
+		}
+	}
+
+	// Tampoco se usa la caché si la petición dice no-store
+	o := &origin{cacheControl: "max-age=60"}
+	c, _, url := newClient(t, o, nil)
+	get(t, c, url)
+	get(t, c, url, "Cache-Control", "no-store")
+	if o.requests.Load() != 2 {
+		t.Errorf("no-store en la petición usó la caché")
+	}
+}
+
+func TestDiskStore(t *testing.T) {
+	store := DiskStore{Dir: t.TempDir()}
+	o := &origin{cacheControl: "max-age=60"}
+	c, _, url := newClient(t, o, store)
+	get(t, c, url)
+
+	// Otro Transport con el mismo directorio usa lo guardado
+	c2 := &red.Client{Transport: &Transport{Base: c.Transport.(*Transport).Base, Store: DiskStore{Dir: store.Dir}}}
+	if resp := get(t, c2, url); resp.Header.Get("X-Cache") != "HIT" {
+		t.Error("la segunda instancia no leyó la caché en disco")
+	}
+	if o.requests.Load() != 1 {
+		t.Errorf("%d peticiones, se esperaba 1", o.requests.Load())
+	}
+
+	store.Delete(url)
+	if _, ok := store.Get(url); ok {
+		t.Error("Delete no borró la entrada")
+	}
+}
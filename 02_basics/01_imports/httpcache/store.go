@@ -0,0 +1,105 @@
+package httpcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	red "net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Entry es una respuesta guardada.
+type Entry struct {
+	StatusCode int        `json:"status_code"`
+	Header     red.Header `json:"header"`
+	Body       []byte     `json:"body"`
+	Stored     time.Time  `json:"stored"` // Cuándo se descargó o revalidó por última vez
+}
+
+// Store guarda las respuestas. La caché es un atajo: si un Store no puede
+// guardar una respuesta simplemente la vuelve a descargar la próxima vez.
+type Store interface {
+	Get(key string) (*Entry, bool)
+	Set(key string, e *Entry)
+	Delete(key string)
+}
+
+// MemoryStore guarda las respuestas en un mapa.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	entries map[string]*Entry
+}
+
+// NewMemoryStore crea un MemoryStore vacío.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: make(map[string]*Entry)}
+}
+
+func (s *MemoryStore) Get(key string) (*Entry, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	e, ok := s.entries[key]
+	return e, ok
+}
+
+func (s *MemoryStore) Set(key string, e *Entry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = e
+}
+
+func (s *MemoryStore) Delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, key)
+}
+
+// DiskStore guarda cada respuesta en un archivo JSON dentro de Dir, con el
+// hash de la clave como nombre.
+type DiskStore struct {
+	Dir string
+}
+
+func (s DiskStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.Dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (s DiskStore) Get(key string) (*Entry, bool) {
+	data, err := os.ReadFile(s.path(key))
+	if err != nil {
+		return nil, false
+	}
+	var e Entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, false
+	}
+	return &e, true
+}
+
+func (s DiskStore) Set(key string, e *Entry) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(s.Dir, 0o755); err != nil {
+		return
+	}
+	// Escribir y renombrar evita que otro proceso lea un archivo a medias
+	tmp, err := os.CreateTemp(s.Dir, "entry-*.tmp")
+	if err != nil {
+		return
+	}
+	_, werr := tmp.Write(data)
+	cerr := tmp.Close()
+	if werr != nil || cerr != nil || os.Rename(tmp.Name(), s.path(key)) != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+func (s DiskStore) Delete(key string) {
+	os.Remove(s.path(key))
+}
@@ -1,21 +1,76 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"fmt"
 	red "net/http"
+	"time"
+
+	"github.com/FepDev25/gobootcamp/02_basics/01_imports/cassette"
+	"github.com/FepDev25/gobootcamp/02_basics/01_imports/httpcache"
+	"github.com/FepDev25/gobootcamp/02_basics/01_imports/jsonplaceholder"
+	"github.com/FepDev25/gobootcamp/02_basics/01_imports/retry"
 )
 
 // Ejemplo de uso de múltiples importaciones
+//
+// Sin red: go run . -cassette testdata/posts_1.json
+// Grabar:  go run . -cassette nuevo.json -record
+// Caché en disco: go run . -cache-dir /tmp/gobootcamp-cache
 func main() {
+	rutaCassette := flag.String("cassette", "", "reproduce las respuestas de este archivo en lugar de usar la red")
+	grabar := flag.Bool("record", false, "graba las respuestas reales en el archivo de -cassette")
+	dirCache := flag.String("cache-dir", "", "guarda la caché HTTP en este directorio (por defecto en memoria)")
+	flag.Parse()
+
 	fmt.Println("¡Hola, mundo!")
 
-	resp, err := red.Get("http://jsonplaceholder.typicode.com/posts/1")
-	if err != nil {
-		fmt.Println("Error:", err)
-		return
+	// Reintenta si el servidor falla
+	var transporte red.RoundTripper = &retry.Transport{}
+	if *rutaCassette != "" {
+		modo := cassette.Replay
+		if *grabar {
+			modo = cassette.Record
+		}
+		c, err := cassette.New(*rutaCassette, modo)
+		if err != nil {
+			fmt.Println("Error:", err)
+			return
+		}
+		c.Base = transporte // Al grabar, las peticiones reales también se reintentan
+		transporte = c
+	}
+
+	// No vuelve a descargar lo que no cambió
+	cache := &httpcache.Transport{Base: transporte}
+	if *dirCache != "" {
+		cache.Store = httpcache.DiskStore{Dir: *dirCache}
 	}
-	defer resp.Body.Close()
 
-	fmt.Println("Respuesta del servidor: ", resp.Status)
+	cliente := &jsonplaceholder.Client{
+		BaseURL: "http://jsonplaceholder.typicode.com",
+		HTTPClient: &red.Client{
+			Timeout:   30 * time.Second,
+			Transport: cache,
+		},
+	}
+
+	// La segunda vez el post sale de la caché
+	for range 2 {
+		post, err := cliente.GetPost(context.Background(), 1)
+		if errors.Is(err, jsonplaceholder.ErrNotFound) {
+			fmt.Println("El post no existe")
+			return
+		}
+		if err != nil {
+			fmt.Println("Error:", err)
+			return
+		}
+
+		fmt.Println("Post:", post.ID, post.Title)
+	}
+	fmt.Println("Caché:", cache.Stats())
 
 }
@@ -0,0 +1,211 @@
+// Package jsonplaceholder es un cliente tipado para la API de pruebas
+// https://jsonplaceholder.typicode.com que usa la lección 01_imports.
+package jsonplaceholder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	red "net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// DefaultBaseURL es la dirección pública de la API.
+const DefaultBaseURL = "https://jsonplaceholder.typicode.com"
+
+// DefaultTimeout se aplica a cada petición cuyo contexto no tiene plazo.
+const DefaultTimeout = 10 * time.Second
+
+// maxErrorBody limita cuánto del cuerpo se guarda en un APIError.
+const maxErrorBody = 4 << 10
+
+// ErrNotFound indica que el recurso pedido no existe (HTTP 404).
+var ErrNotFound = errors.New("jsonplaceholder: recurso no encontrado")
+
+// APIError es una respuesta de la API con un código de estado inesperado.
+type APIError struct {
+	StatusCode int
+	Status     string
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("jsonplaceholder: respuesta inesperada %s: %s", e.Status, e.Body)
+}
+
+// Unwrap permite usar errors.Is(err, ErrNotFound) con las respuestas 404.
+func (e *APIError) Unwrap() error {
+	if e.StatusCode == red.StatusNotFound {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Post es una publicación.
+type Post struct {
+	UserID int    `json:"userId"`
+	ID     int    `json:"id,omitempty"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+}
+
+// User es un usuario de la API.
+type User struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+	Phone    string `json:"phone"`
+	Website  string `json:"website"`
+}
+
+// Comment es un comentario sobre una publicación.
+type Comment struct {
+	PostID int    `json:"postId"`
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Email  string `json:"email"`
+	Body   string `json:"body"`
+}
+
+// PostFilter restringe ListPosts. Los campos en cero no filtran.
+type PostFilter struct {
+	UserID int
+}
+
+// Client llama a la API. El valor cero usa DefaultBaseURL y
+// red.DefaultClient.
+type Client struct {
+	BaseURL    string
+	HTTPClient *red.Client
+}
+
+// GetPost devuelve la publicación id.
+func (c *Client) GetPost(ctx context.Context, id int) (*Post, error) {
+	var p Post
+	if err := c.do(ctx, red.MethodGet, "/posts/"+strconv.Itoa(id), nil, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// ListPosts devuelve las publicaciones que cumplen el filtro.
+func (c *Client) ListPosts(ctx context.Context, filter PostFilter) ([]Post, error) {
+	query := url.Values{}
+	if filter.UserID != 0 {
+		query.Set("userId", strconv.Itoa(filter.UserID))
+	}
+	path := "/posts"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	var posts []Post
+	if err := c.do(ctx, red.MethodGet, path, nil, &posts); err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// CreatePost crea una publicación y devuelve la versión guardada, con su ID.
+func (c *Client) CreatePost(ctx context.Context, p Post) (*Post, error) {
+	var created Post
+	if err := c.do(ctx, red.MethodPost, "/posts", p, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdatePost reemplaza la publicación p.ID.
+func (c *Client) UpdatePost(ctx context.Context, p Post) (*Post, error) {
+	if p.ID == 0 {
+		return nil, errors.New("jsonplaceholder: UpdatePost necesita el ID de la publicación")
+	}
+	var updated Post
+	if err := c.do(ctx, red.MethodPut, "/posts/"+strconv.Itoa(p.ID), p, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeletePost borra la publicación id.
+func (c *Client) DeletePost(ctx context.Context, id int) error {
+	return c.do(ctx, red.MethodDelete, "/posts/"+strconv.Itoa(id), nil, nil)
+}
+
+// GetUser devuelve el usuario id.
+func (c *Client) GetUser(ctx context.Context, id int) (*User, error) {
+	var u User
+	if err := c.do(ctx, red.MethodGet, "/users/"+strconv.Itoa(id), nil, &u); err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// ListComments devuelve los comentarios de la publicación postID.
+func (c *Client) ListComments(ctx context.Context, postID int) ([]Comment, error) {
+	var comments []Comment
+	if err := c.do(ctx, red.MethodGet, "/posts/"+strconv.Itoa(postID)+"/comments", nil, &comments); err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+// do envía la petición, revisa el código de estado y decodifica la
+// respuesta en out si no es nil.
+func (c *Client) do(ctx context.Context, method, path string, in, out any) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, DefaultTimeout)
+		defer cancel()
+	}
+
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	base := c.BaseURL
+	if base == "" {
+		base = DefaultBaseURL
+	}
+	req, err := red.NewRequestWithContext(ctx, method, base+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = red.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return &APIError{StatusCode: resp.StatusCode, Status: resp.Status, Body: string(data)}
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("jsonplaceholder: respuesta inválida de %s %s: %w", method, path, err)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package jsonplaceholder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	red "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newClient(t *testing.T, h red.HandlerFunc) *Client {
+	t.Helper()
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+	return &Client{BaseURL: srv.URL, HTTPClient: srv.Client()}
+}
+
+func TestGetPost(t *testing.T) {
+	c := newClient(t, func(w red.ResponseWriter, r *red.Request) {
+		if r.Method != red.MethodGet || r.URL.Path != "/posts/1" {
+			t.Errorf("petición %s %s, se esperaba GET /posts/1", r.Method, r.URL.Path)
+		}
+		if got := r.Header.Get("Accept"); got != "application/json" {
+			t.Errorf("Accept = %q, se esperaba application/json", got)
+		}
+		fmt.Fprint(w, `{"userId":1,"id":1,"title":"hola","body":"mundo"}`)
+	})
+	p, err := c.GetPost(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Post{UserID: 1, ID: 1, Title: "hola", Body: "mundo"}); *p != want {
+		t.Errorf("GetPost = %+v, se esperaba %+v", *p, want)
+	}
+}
+
+func TestCreatePostSendsJSON(t *testing.T) {
+	c := newClient(t, func(w red.ResponseWriter, r *red.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if got := r.Header.Get("Content-Type"); !strings.HasPrefix(got, "application/json") {
+			t.Errorf("Content-Type = %q, se esperaba application/json", got)
+		}
+		if want := `{"userId":2,"title":"t","body":"b"}`; string(body) != want {
+			t.Errorf("cuerpo %s, se esperaba %s", body, want)
+		}
+		w.WriteHeader(red.StatusCreated)
+		fmt.Fprint(w, `{"userId":2,"id":101,"title":"t","body":"b"}`)
+	})
+	p, err := c.CreatePost(context.Background(), Post{UserID: 2, Title: "t", Body: "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.ID != 101 {
+		t.Errorf("ID = %d, se esperaba 101", p.ID)
+	}
+}
+
+func TestNotFound(t *testing.T) {
+	c := newClient(t, func(w red.ResponseWriter, r *red.Request) {
+		w.WriteHeader(red.StatusNotFound)
+		fmt.Fprint(w, "{}")
+	})
+	_, err := c.GetUser(context.Background(), 99)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("error %v, se esperaba ErrNotFound", err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != red.StatusNotFound {
+		t.Errorf("error %v, se esperaba un *APIError 404", err)
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	long := strings.Repeat("x", maxErrorBody+100)
+	tests := []struct {
+		status int
+		body   string
+		want   string // Body guardado en el error
+	}{
+		{red.StatusInternalServerError, "se cayó", "se cayó"},
+		{red.StatusBadRequest, `{"error":"título vacío"}`, `{"error":"título vacío"}`},
+		{red.StatusBadGateway, long, long[:maxErrorBody]},
+	}
+	for _, tt := range tests {
+		c := newClient(t, func(w red.ResponseWriter, r *red.Request) {
+			w.WriteHeader(tt.status)
+			fmt.Fprint(w, tt.body)
+		})
+		err := c.DeletePost(context.Background(), 1)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Errorf("%d: error %v, se esperaba *APIError", tt.status, err)
+			continue
+		}
+		if apiErr.StatusCode != tt.status || !strings.HasPrefix(apiErr.Status, fmt.Sprint(tt.status)) {
+			t.Errorf("%d: StatusCode %d y Status %q", tt.status, apiErr.StatusCode, apiErr.Status)
+		}
+		if apiErr.Body != tt.want {
+			t.Errorf("%d: Body de %d bytes, se esperaban %d", tt.status, len(apiErr.Body), len(tt.want))
+		}
+		if errors.Is(err, ErrNotFound) {
+			t.Errorf("%d: errors.Is(err, ErrNotFound) = true", tt.status)
+		}
+	}
+}
+
+func TestMalformedJSON(t *testing.T) {
+	c := newClient(t, func(w red.ResponseWriter, r *red.Request) {
+		fmt.Fprint(w, `[{"id": 1,`)
+	})
+	_, err := c.ListComments(context.Background(), 1)
+	if err == nil || !strings.Contains(err.Error(), "respuesta inválida de GET /posts/1/comments") {
+		t.Errorf("error %v, se esperaba respuesta inválida", err)
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		t.Errorf("un JSON inválido con 200 no es un *APIError: %v", err)
+	}
+}
+
+func TestUpdatePostNeedsID(t *testing.T) {
+	c := newClient(t, func(w red.ResponseWriter, r *red.Request) {
+		t.Error("no debía enviarse ninguna petición")
+	})
+	if _, err := c.UpdatePost(context.Background(), Post{Title: "sin id"}); err == nil {
+		t.Error("UpdatePost sin ID no devolvió error")
+	}
+}
+
+// deadlineTransport guarda el plazo del contexto de cada petición.
+type deadlineTransport struct {
+	base     red.RoundTripper
+	deadline time.Time
+	ok       bool
+}
+
+func (d *deadlineTransport) RoundTrip(r *red.Request) (*red.Response, error) {
+	d.deadline, d.ok = r.Context().Deadline()
+	return d.base.RoundTrip(r)
+}
+
+func TestDefaultTimeout(t *testing.T) {
+	srv := httptest.NewServer(red.HandlerFunc(func(w red.ResponseWriter, r *red.Request) {
+		fmt.Fprint(w, `[]`)
+	}))
+	t.Cleanup(srv.Close)
+	tr := &deadlineTransport{base: srv.Client().Transport}
+	c := &Client{BaseURL: srv.URL, HTTPClient: &red.Client{Transport: tr}}
+
+	start := time.Now()
+	if _, err := c.ListPosts(context.Background(), PostFilter{UserID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	end := time.Now()
+	if !tr.ok {
+		t.Fatal("la petición no tenía plazo; se esperaba DefaultTimeout")
+	}
+	if tr.deadline.Before(start.Add(DefaultTimeout)) || tr.deadline.After(end.Add(DefaultTimeout)) {
+		t.Errorf("plazo a %v del inicio, se esperaba %v", tr.deadline.Sub(start), DefaultTimeout)
+	}
+
+	// El plazo del que llama se respeta
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	want, _ := ctx.Deadline()
+	if _, err := c.ListPosts(ctx, PostFilter{}); err != nil {
+		t.Fatal(err)
+	}
+	if !tr.deadline.Equal(want) {
+		t.Errorf("plazo %v, se esperaba el del contexto %v", tr.deadline, want)
+	}
+}
+
+func TestTimeoutExpires(t *testing.T) {
+	c := newClient(t, func(w red.ResponseWriter, r *red.Request) {
+		<-r.Context().Done()
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.GetPost(ctx, 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error %v, se esperaba context.DeadlineExceeded", err)
+	}
+}
@@ -0,0 +1,153 @@
+// Package retry reintenta peticiones HTTP idempotentes cuando el servidor o
+// la red fallan, con espera exponencial y jitter completo.
+package retry
+
+import (
+	"io"
+	"math/rand/v2"
+	red "net/http"
+	"strconv"
+	"time"
+)
+
+// Valores por defecto de Transport.
+const (
+	DefaultMaxRetries      = 3
+	DefaultInitialInterval = 200 * time.Millisecond
+	DefaultMaxInterval     = 5 * time.Second
+	DefaultMaxElapsedTime  = 30 * time.Second
+)
+
+// Transport es un red.RoundTripper que reintenta las peticiones idempotentes
+// ante errores de conexión, 429 y 5xx. Los campos en cero usan los valores
+// por defecto.
+type Transport struct {
+	Base red.RoundTripper // Transporte real; nil usa red.DefaultTransport
+
+	// MaxRetries son los reintentos después del primer intento. nil usa
+	// DefaultMaxRetries; un puntero a 0, como new(int), desactiva los
+	// reintentos.
+	MaxRetries      *int
+	InitialInterval time.Duration // Tope de la primera espera
+	MaxInterval     time.Duration // Tope de cualquier espera
+	MaxElapsedTime  time.Duration // Tiempo total tras el cual no se reintenta
+}
+
+// RoundTrip implementa red.RoundTripper.
+func (t *Transport) RoundTrip(req *red.Request) (*red.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = red.DefaultTransport
+	}
+	if !retryable(req) {
+		return base.RoundTrip(req)
+	}
+
+	ctx := req.Context()
+	start := time.Now()
+	for attempt := 0; ; attempt++ {
+		r := req
+		if attempt > 0 && req.GetBody != nil {
+			// Cada intento necesita un cuerpo sin leer
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r = req.Clone(ctx)
+			r.Body = body
+		}
+
+		resp, err := base.RoundTrip(r)
+		if !shouldRetry(resp, err) || ctx.Err() != nil || attempt >= t.maxRetries() {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt)
+		if resp != nil {
+			if after, ok := retryAfter(resp); ok {
+				wait = after
+			}
+		}
+		if time.Since(start)+wait > or(t.MaxElapsedTime, DefaultMaxElapsedTime) {
+			return resp, err
+		}
+		if resp != nil {
+			// Vaciar el cuerpo permite reutilizar la conexión
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 4<<10))
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *Transport) maxRetries() int {
+	if t.MaxRetries == nil {
+		return DefaultMaxRetries
+	}
+	return max(*t.MaxRetries, 0)
+}
+
+// backoff devuelve una espera aleatoria entre cero y
+// min(MaxInterval, InitialInterval * 2^attempt): el "full jitter" que evita
+// que muchos clientes reintenten al mismo tiempo.
+func (t *Transport) backoff(attempt int) time.Duration {
+	limit := or(t.MaxInterval, DefaultMaxInterval)
+	ceiling := or(t.InitialInterval, DefaultInitialInterval)
+	for i := 0; i < attempt && ceiling < limit; i++ {
+		ceiling *= 2
+	}
+	ceiling = min(ceiling, limit)
+	return time.Duration(rand.Int64N(int64(ceiling) + 1))
+}
+
+// retryable indica si la petición se puede repetir sin efectos extra.
+func retryable(req *red.Request) bool {
+	if req.Body != nil && req.Body != red.NoBody && req.GetBody == nil {
+		return false // No hay forma de volver a enviar el cuerpo
+	}
+	switch req.Method {
+	case red.MethodGet, red.MethodHead, red.MethodOptions, red.MethodTrace, red.MethodPut, red.MethodDelete:
+		return true
+	}
+	return req.Header.Get("Idempotency-Key") != ""
+}
+
+func shouldRetry(resp *red.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	// 501 significa que el servidor no sabe atender el método: repetir la
+	// petición no cambia la respuesta
+	return resp.StatusCode == red.StatusTooManyRequests ||
+		resp.StatusCode >= 500 && resp.StatusCode != red.StatusNotImplemented
+}
+
+// retryAfter lee el encabezado Retry-After, en segundos o como fecha HTTP.
+func retryAfter(resp *red.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(value); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if at, err := red.ParseTime(value); err == nil {
+		return max(0, time.Until(at)), true
+	}
+	return 0, false
+}
+
+func or[T comparable](v, def T) T {
+	var zero T
+	if v == zero {
+		return def
+	}
+	return v
+}
@@ -0,0 +1,188 @@
+package retry
+
+import (
+	"io"
+	red "net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// failing responde status las primeras failures peticiones y luego 200.
+// Guarda el momento y el cuerpo de cada intento.
+type failing struct {
+	status     int
+	failures   int
+	retryAfter string
+
+	mu     sync.Mutex
+	times  []time.Time
+	bodies []string
+}
+
+func (f *failing) ServeHTTP(w red.ResponseWriter, r *red.Request) {
+	body, _ := io.ReadAll(r.Body)
+	f.mu.Lock()
+	f.times = append(f.times, time.Now())
+	f.bodies = append(f.bodies, string(body))
+	n := len(f.times)
+	f.mu.Unlock()
+
+	if n <= f.failures {
+		if f.retryAfter != "" {
+			w.Header().Set("Retry-After", f.retryAfter)
+		}
+		w.WriteHeader(f.status)
+		io.WriteString(w, "fallo")
+		return
+	}
+	io.WriteString(w, "ok")
+}
+
+func (f *failing) attempts() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.times)
+}
+
+func do(t *testing.T, f *failing, tr *Transport, method, body string) *red.Response {
+	t.Helper()
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+	tr.Base = srv.Client().Transport
+
+	var r io.Reader
+	if body != "" {
+		r = strings.NewReader(body)
+	}
+	req, err := red.NewRequest(method, srv.URL, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := (&red.Client{Transport: tr}).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	return resp
+}
+
+func fast() *Transport {
+	return &Transport{InitialInterval: time.Millisecond, MaxInterval: 4 * time.Millisecond}
+}
+
+func TestRetriesUntilSuccess(t *testing.T) {
+	for _, status := range []int{429, 500, 502, 503, 504, 507, 599} {
+		f := &failing{status: status, failures: 2}
+		resp := do(t, f, fast(), red.MethodGet, "")
+		if resp.StatusCode != red.StatusOK || f.attempts() != 3 {
+			t.Errorf("%d: estado %d tras %d intentos; se esperaba 200 tras 3", status, resp.StatusCode, f.attempts())
+		}
+	}
+}
+
+func TestGivesUpAfterMaxRetries(t *testing.T) {
+	f := &failing{status: red.StatusServiceUnavailable, failures: 100}
+	tr := fast()
+	retries := 4
+	tr.MaxRetries = &retries
+	resp := do(t, f, tr, red.MethodGet, "")
+	if resp.StatusCode != red.StatusServiceUnavailable || f.attempts() != 5 {
+		t.Errorf("estado %d tras %d intentos; se esperaba 503 tras 5", resp.StatusCode, f.attempts())
+	}
+
+	f = &failing{status: red.StatusInternalServerError, failures: 100}
+	do(t, f, fast(), red.MethodGet, "")
+	if f.attempts() != DefaultMaxRetries+1 {
+		t.Errorf("%d intentos con MaxRetries en nil; se esperaban %d", f.attempts(), DefaultMaxRetries+1)
+	}
+}
+
+func TestMaxRetriesZeroDisables(t *testing.T) {
+	for _, retries := range []int{0, -1} {
+		f := &failing{status: red.StatusServiceUnavailable, failures: 100}
+		tr := fast()
+		tr.MaxRetries = &retries
+		resp := do(t, f, tr, red.MethodGet, "")
+		if resp.StatusCode != red.StatusServiceUnavailable || f.attempts() != 1 {
+			t.Errorf("MaxRetries %d: estado %d tras %d intentos; se esperaba 503 tras 1", retries, resp.StatusCode, f.attempts())
+		}
+	}
+}
+
+func TestDoesNotRetry(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		method string
+	}{
+		{"400", red.StatusBadRequest, red.MethodGet},
+		{"404", red.StatusNotFound, red.MethodGet},
+		{"501", red.StatusNotImplemented, red.MethodGet},
+		{"POST sin Idempotency-Key", red.StatusServiceUnavailable, red.MethodPost},
+	}
+	for _, tt := range tests {
+		f := &failing{status: tt.status, failures: 100}
+		do(t, f, fast(), tt.method, "")
+		if f.attempts() != 1 {
+			t.Errorf("%s: %d intentos, se esperaba 1", tt.name, f.attempts())
+		}
+	}
+}
+
+func TestRetryResendsBody(t *testing.T) {
+	f := &failing{status: red.StatusBadGateway, failures: 2}
+	do(t, f, fast(), red.MethodPut, "datos")
+	if f.attempts() != 3 {
+		t.Fatalf("%d intentos, se esperaban 3", f.attempts())
+	}
+	for i, body := range f.bodies {
+		if body != "datos" {
+			t.Errorf("intento %d recibió el cuerpo %q", i+1, body)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tr := &Transport{InitialInterval: 10 * time.Millisecond, MaxInterval: 80 * time.Millisecond}
+	for attempt, ceiling := range []time.Duration{10, 20, 40, 80, 80, 80} {
+		ceiling *= time.Millisecond
+		for range 200 {
+			if d := tr.backoff(attempt); d < 0 || d > ceiling {
+				t.Fatalf("backoff(%d) = %v, fuera de [0, %v]", attempt, d, ceiling)
+			}
+		}
+	}
+
+	// Las esperas reales entre intentos respetan el mismo tope
+	f := &failing{status: red.StatusServiceUnavailable, failures: 3}
+	tr = &Transport{InitialInterval: 20 * time.Millisecond, MaxInterval: 40 * time.Millisecond}
+	do(t, f, tr, red.MethodGet, "")
+	ceilings := []time.Duration{20, 40, 40}
+	for i := 1; i < len(f.times); i++ {
+		// Margen para la latencia del servidor de prueba
+		if gap := f.times[i].Sub(f.times[i-1]); gap > ceilings[i-1]*time.Millisecond+30*time.Millisecond {
+			t.Errorf("espera %d: %v, el tope era %vms", i, gap, ceilings[i-1])
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	f := &failing{status: red.StatusTooManyRequests, failures: 1, retryAfter: "1"}
+	start := time.Now()
+	do(t, f, fast(), red.MethodGet, "")
+	if elapsed := time.Since(start); elapsed < time.Second || f.attempts() != 2 {
+		t.Errorf("Retry-After: 1 esperó %v en %d intentos", elapsed, f.attempts())
+	}
+
+	// Si Retry-After supera MaxElapsedTime se devuelve la respuesta sin esperar
+	f = &failing{status: red.StatusServiceUnavailable, failures: 1, retryAfter: "60"}
+	tr := fast()
+	tr.MaxElapsedTime = time.Second
+	if resp := do(t, f, tr, red.MethodGet, ""); resp.StatusCode != red.StatusServiceUnavailable || f.attempts() != 1 {
+		t.Errorf("estado %d tras %d intentos; se esperaba 503 tras 1", resp.StatusCode, f.attempts())
+	}
+}
@@ -0,0 +1,187 @@
+// complexcalc es una calculadora interactiva de números complejos.
+//
+// Ej: go run ./02_basics/02_data_types/cmd/complexcalc
+//
+//	> (1 + 2i) * conj(3 - i)
+//	1 + 7i
+//	> :quad 1, 2, 5
+//	x1 = -1 + 2i
+//	x2 = -1 - 2i
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/FepDev25/gobootcamp/02_basics/02_data_types/complexcalc"
+)
+
+const help = `Expresiones: + - * / ^, paréntesis, 2i, i, pi, e, ans
+Asignación:  z = 3 + 4i
+Funciones:   %s
+Comandos:
+  :quad a, b, c   resuelve a·x² + b·x + c = 0, o b·x + c = 0 si a es 0
+  :polar          muestra los resultados como r∠θ
+  :rect           muestra los resultados como a + bi
+  :prec 64|128    usa complex64 o complex128
+  :vars           lista las variables
+  :help           muestra esta ayuda
+  :quit           sale
+`
+
+type repl struct {
+	calc  *complexcalc.Calculator
+	polar bool
+	out   io.Writer
+}
+
+func main() {
+	prec := flag.Int("prec", 128, "precisión: 64 (complex64) o 128 (complex128)")
+	polar := flag.Bool("polar", false, "muestra los resultados en forma polar")
+	expr := flag.String("e", "", "evalúa esta expresión y sale")
+	flag.Parse()
+
+	r := &repl{calc: complexcalc.New(complexcalc.Complex128), polar: *polar, out: os.Stdout}
+	if err := r.setPrecision(fmt.Sprint(*prec)); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(2)
+	}
+
+	if *expr != "" {
+		if !r.run(*expr) {
+			os.Exit(1)
+		}
+		return
+	}
+
+	fmt.Fprintf(r.out, "Calculadora compleja (%s). Escribe :help para ver la ayuda.\n", r.calc.Precision)
+	scanner := bufio.NewScanner(os.Stdin)
+	for {
+		fmt.Fprint(r.out, "> ")
+		if !scanner.Scan() {
+			fmt.Fprintln(r.out)
+			return
+		}
+		line := strings.TrimSpace(scanner.Text())
+		if line == ":quit" || line == ":q" {
+			return
+		}
+		if line != "" {
+			r.run(line)
+		}
+	}
+}
+
+// run ejecuta una línea y devuelve false si hubo un error.
+func (r *repl) run(line string) bool {
+	var err error
+	cmd, arg, _ := strings.Cut(line, " ")
+	switch cmd {
+	case ":help":
+		fmt.Fprintf(r.out, help, strings.Join(complexcalc.Functions(), ", "))
+	case ":polar", ":rect":
+		r.polar = cmd == ":polar"
+	case ":prec":
+		err = r.setPrecision(strings.TrimSpace(arg))
+	case ":vars":
+		r.printVars()
+	case ":quad":
+		err = r.quadratic(arg)
+	default:
+		if strings.HasPrefix(cmd, ":") {
+			err = fmt.Errorf("comando desconocido %s", cmd)
+			break
+		}
+		var z complex128
+		if z, err = r.calc.Eval(line); err == nil {
+			fmt.Fprintln(r.out, r.calc.Format(z, r.polar))
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(r.out, "Error:", err)
+		return false
+	}
+	return true
+}
+
+func (r *repl) setPrecision(bits string) error {
+	switch bits {
+	case "64":
+		r.calc.Precision = complexcalc.Complex64
+	case "128":
+		r.calc.Precision = complexcalc.Complex128
+	default:
+		return fmt.Errorf("precisión %q no válida, usa 64 o 128", bits)
+	}
+	return nil
+}
+
+func (r *repl) quadratic(arg string) error {
+	parts := splitArgs(arg)
+	if len(parts) != 3 {
+		return fmt.Errorf("uso: :quad a, b, c")
+	}
+	var coef [3]complex128
+	for i, p := range parts {
+		// Los coeficientes no cambian ans ni aceptan asignaciones
+		z, err := r.calc.EvalExpr(p)
+		if err != nil {
+			return fmt.Errorf("coeficiente %c: %w", 'a'+i, err)
+		}
+		coef[i] = z
+	}
+	x1, x2, err := complexcalc.Quadratic(coef[0], coef[1], coef[2])
+	if errors.Is(err, complexcalc.ErrNotQuadratic) {
+		x, err := complexcalc.Linear(coef[1], coef[2])
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(r.out, "x =", r.calc.Format(x, r.polar))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(r.out, "x1 =", r.calc.Format(x1, r.polar))
+	fmt.Fprintln(r.out, "x2 =", r.calc.Format(x2, r.polar))
+	return nil
+}
+
+// splitArgs separa s en las comas que no están entre paréntesis, así
+// rect(1, 0) queda como un solo argumento.
+func splitArgs(s string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, s[start:])
+}
+
+func (r *repl) printVars() {
+	vars := r.calc.Vars()
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(r.out, "%s = %s\n", name, r.calc.Format(vars[name], r.polar))
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/FepDev25/gobootcamp/02_basics/02_data_types/complexcalc"
+)
+
+func TestSplitArgs(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"1, 2, 5", []string{"1", " 2", " 5"}},
+		{"1, rect(1,0), 1", []string{"1", " rect(1,0)", " 1"}},
+		{"(1+i)*2, rect(1, pi), sqrt(-4)", []string{"(1+i)*2", " rect(1, pi)", " sqrt(-4)"}},
+		{"1", []string{"1"}},
+		{"", []string{""}},
+	}
+	for _, tt := range tests {
+		if got := splitArgs(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("splitArgs(%q) = %q, se esperaba %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestQuadratic(t *testing.T) {
+	var out strings.Builder
+	r := &repl{calc: complexcalc.New(complexcalc.Complex128), out: &out}
+	r.run("ans = 7")
+
+	tests := []struct{ arg, want string }{
+		{"1, 2, 5", "x1 = -1 - 2i\nx2 = -1 + 2i\n"},
+		{"1, rect(2, 0), 5", "x1 = -1 - 2i\nx2 = -1 + 2i\n"},
+		{"0, 2, 4", "x = -2\n"},
+	}
+	for _, tt := range tests {
+		out.Reset()
+		if err := r.quadratic(tt.arg); err != nil {
+			t.Errorf(":quad %s: %v", tt.arg, err)
+			continue
+		}
+		if out.String() != tt.want {
+			t.Errorf(":quad %s escribió %q, se esperaba %q", tt.arg, out.String(), tt.want)
+		}
+	}
+	for _, arg := range []string{"1, 2", "x = 1, 2, 3", "1, 2, 3, 4"} {
+		if err := r.quadratic(arg); err == nil {
+			t.Errorf(":quad %s no devolvió error", arg)
+		}
+	}
+	if ans := r.calc.Vars()["ans"]; ans != 7 {
+		t.Errorf("ans = %v después de :quad, se esperaba 7", ans)
+	}
+	if _, ok := r.calc.Vars()["x"]; ok {
+		t.Error(":quad definió la variable x")
+	}
+}
@@ -0,0 +1,101 @@
+// convert pasa una lista de Usuario o Persona de un formato a otro.
+//
+// Ej: go run ./02_basics/02_data_types/cmd/convert -type persona -i personas.json -o personas.csv
+//
+//	cat personas.csv | go run ./02_basics/02_data_types/cmd/convert -type persona -from csv -to kv
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/FepDev25/gobootcamp/02_basics/02_data_types/serial"
+)
+
+func main() {
+	typ := flag.String("type", "persona", "tipo de los registros: usuario o persona")
+	from := flag.String("from", "", "formato de entrada; por defecto según la extensión de -i")
+	to := flag.String("to", "", "formato de salida; por defecto según la extensión de -o")
+	input := flag.String("i", "", "archivo de entrada (por defecto stdin)")
+	output := flag.String("o", "", "archivo de salida (por defecto stdout)")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Uso: convert [opciones]")
+		fmt.Fprintln(os.Stderr, "\nFormatos:", formatList())
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := run(*typ, *from, *to, *input, *output); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+func run(typ, from, to, input, output string) error {
+	inFormat, err := resolve(from, input, "-from")
+	if err != nil {
+		return err
+	}
+	outFormat, err := resolve(to, output, "-to")
+	if err != nil {
+		return err
+	}
+
+	r := io.Reader(os.Stdin)
+	if input != "" {
+		f, err := os.Open(input)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	w := io.Writer(os.Stdout)
+	if output != "" {
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	switch strings.ToLower(typ) {
+	case "usuario":
+		return convert[serial.Usuario](r, w, inFormat, outFormat)
+	case "persona":
+		return convert[serial.Persona](r, w, inFormat, outFormat)
+	}
+	return fmt.Errorf("tipo desconocido %q, usa usuario o persona", typ)
+}
+
+func convert[T serial.Record](r io.Reader, w io.Writer, from, to serial.Format) error {
+	items, err := serial.Decode[T](r, from)
+	if err != nil {
+		return err
+	}
+	return serial.Encode(w, to, items)
+}
+
+// resolve usa el formato indicado o lo deduce del nombre del archivo.
+func resolve(format, path, flagName string) (serial.Format, error) {
+	if format != "" {
+		return serial.Format(strings.ToLower(format)), nil
+	}
+	if path == "" {
+		return "", fmt.Errorf("indica %s cuando se usa stdin o stdout", flagName)
+	}
+	return serial.FormatFor(path)
+}
+
+func formatList() string {
+	names := make([]string, len(serial.Formats))
+	for i, f := range serial.Formats {
+		names[i] = string(f)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,85 @@
+// describe muestra el árbol de los valores cero de la lección, y de una
+// lista enlazada circular, usando el paquete describe.
+//
+// Ej: go run ./02_basics/02_data_types/cmd/describe
+//
+//	go run ./02_basics/02_data_types/cmd/describe --json
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/FepDev25/gobootcamp/02_basics/02_data_types/describe"
+)
+
+type Usuario struct {
+	Nombre string
+	Edad   int
+}
+
+// Nodo se apunta a sí mismo a través de Siguiente en una lista circular.
+type Nodo struct {
+	Valor     int
+	Siguiente *Nodo
+}
+
+func main() {
+	asJSON := flag.Bool("json", false, "escribe el árbol como JSON")
+	depth := flag.Int("depth", 0, "profundidad máxima (0 usa 10)")
+	flag.Parse()
+
+	// Los mismos valores cero de especiales()
+	var entero int
+	var flotante float64
+	var booleano bool
+	var cadena string
+	var arreglo [5]int
+	var slice []int
+	var mapa map[string]int
+	var estructura Usuario
+	var puntero *int
+	var interfaz any
+	var canal chan int
+	var funcion func()
+
+	a := &Nodo{Valor: 1}
+	b := &Nodo{Valor: 2, Siguiente: a}
+	a.Siguiente = b
+
+	valores := struct {
+		Entero     int
+		Flotante   float64
+		Booleano   bool
+		Cadena     string
+		Arreglo    [5]int
+		Slice      []int
+		Mapa       map[string]int
+		Estructura Usuario
+		Puntero    *int
+		Interfaz   any
+		Canal      chan int
+		Funcion    func()
+		Usuarios   []Usuario
+		Circular   *Nodo
+	}{
+		entero, flotante, booleano, cadena, arreglo, slice, mapa, estructura,
+		puntero, interfaz, canal, funcion,
+		[]Usuario{{"Felipe", 20}, {}},
+		a,
+	}
+
+	d := &describe.Describer{MaxDepth: *depth}
+	tree := d.Value(valores)
+	var err error
+	if *asJSON {
+		err = tree.WriteJSON(os.Stdout)
+	} else {
+		err = tree.WriteTree(os.Stdout)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,82 @@
+// ieee754 muestra cómo se guarda un literal en float32 y float64: los bits,
+// el valor exacto, el ULP, los vecinos y el error de redondeo.
+//
+// Ej: go run ./02_basics/02_data_types/cmd/ieee754 3.14 3.14159265358979323846 0.1
+//
+//	go run ./02_basics/02_data_types/cmd/ieee754 -specials -bits 32
+//	go run ./02_basics/02_data_types/cmd/ieee754 -raw -bits 32 0x7fc00001
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/FepDev25/gobootcamp/02_basics/02_data_types/ieee754"
+)
+
+func main() {
+	bits := flag.Int("bits", 0, "32 o 64; 0 muestra ambos formatos")
+	raw := flag.Bool("raw", false, "interpreta los argumentos como bits en hexadecimal")
+	specials := flag.Bool("specials", false, "lista los valores especiales: ceros, subnormales, Inf y NaN")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Uso: ieee754 [opciones] <literal>...")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	var formats []ieee754.Format
+	switch *bits {
+	case 0:
+		formats = []ieee754.Format{ieee754.Float32, ieee754.Float64}
+	case 32:
+		formats = []ieee754.Format{ieee754.Float32}
+	case 64:
+		formats = []ieee754.Format{ieee754.Float64}
+	default:
+		fmt.Fprintln(os.Stderr, "Error: -bits debe ser 32 o 64")
+		os.Exit(2)
+	}
+	if *raw && len(formats) != 1 {
+		fmt.Fprintln(os.Stderr, "Error: -raw necesita -bits 32 o -bits 64")
+		os.Exit(2)
+	}
+	if !*specials && flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if *specials {
+		for _, f := range formats {
+			ieee754.WriteSpecials(os.Stdout, f)
+		}
+	}
+
+	failed := false
+	for _, arg := range flag.Args() {
+		for _, f := range formats {
+			v, err := value(arg, f, *raw)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "Error:", err)
+				failed = true
+				break
+			}
+			v.Write(os.Stdout)
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func value(arg string, f ieee754.Format, raw bool) (*ieee754.Value, error) {
+	if !raw {
+		return ieee754.Parse(arg, f)
+	}
+	bits, err := strconv.ParseUint(arg, 0, f.Bits)
+	if err != nil {
+		return nil, err
+	}
+	return ieee754.FromBits(bits, f), nil
+}
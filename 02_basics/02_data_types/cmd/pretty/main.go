@@ -0,0 +1,51 @@
+// pretty imprime los valores de la lección de tipos de datos, cada tipo con
+// su propio formato, usando el paquete pretty.
+//
+// Ej: go run ./02_basics/02_data_types/cmd/pretty
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/FepDev25/gobootcamp/02_basics/02_data_types/pretty"
+	"github.com/FepDev25/gobootcamp/02_basics/02_data_types/serial"
+)
+
+// Celsius no tiene formato propio: cae en el manejador por defecto.
+type Celsius float64
+
+func main() {
+	x := 45
+	valores := []any{
+		// Primitivos
+		int8(12), int16(1234), int32(12345678), int64(1234567890123456789), 123456,
+		uint8(12), uint16(1234), uint32(12345678), uint64(1234567890123456789), uint(123456), uintptr(0),
+		float32(3.14), 3.14159265358979323846,
+		complex64(1 + 2i), complex128(1 + 2i),
+		true, false,
+		"Hola mundo", "Felipe Peralta",
+
+		// Compuestos
+		[]int{21, 20, 0, 52, 52},
+		[3]string{"Felipe", "Emilia", "Karen"},
+		[2][2]int{{2, 2}, {1, 10}},
+		serial.Persona{Nombre: "Felipe", Edad: 20, Email: "felipe@example.com"},
+		&x,
+		map[string]int{"Felipe": 20, "Emilia": 21},
+		map[string]string{"rojo": "#FF0000", "verde": "#00FF00", "azul": "#0000FF", "amarillo": "#FFFF00"},
+		func(x int, y int) int { return x * y },
+
+		// Especiales
+		serial.Usuario{Nombre: "Felipe", Edad: 20},
+		errors.New("algo salió mal"),
+		90 * time.Minute, // fmt.Stringer
+		Celsius(36.6),
+		nil,
+	}
+
+	for _, v := range valores {
+		fmt.Println(pretty.Format(v))
+	}
+}
@@ -0,0 +1,68 @@
+// typeinfo muestra el tamaño, la alineación, el relleno, el rango y el valor
+// cero de tipos de Go, incluidos los declarados en un archivo fuente.
+//
+// Ej: go run ./02_basics/02_data_types/cmd/typeinfo -dir 02_basics/02_data_types Persona Usuario
+//
+//	go run ./02_basics/02_data_types/cmd/typeinfo int8 float32 'struct{ a bool; b int64; c bool }' 3.14
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/types"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/FepDev25/gobootcamp/02_basics/02_data_types/typeinfo"
+	"github.com/FepDev25/gobootcamp/internal/loader"
+)
+
+func main() {
+	dir := flag.String("dir", "", "paquete donde buscar los tipos")
+	file := flag.String("file", "", "archivo .go donde buscar los tipos")
+	arch := flag.String("arch", runtime.GOARCH, "arquitectura para calcular tamaños, ej. amd64 o 386")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Uso: typeinfo [opciones] <tipo o literal>...")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	sizes := types.SizesFor("gc", *arch)
+	if sizes == nil {
+		fmt.Fprintln(os.Stderr, "Error: arquitectura desconocida:", *arch)
+		os.Exit(1)
+	}
+	r := &typeinfo.Resolver{Sizes: sizes}
+
+	var err error
+	switch {
+	case *file != "":
+		r.Pkg, err = loader.Load(filepath.Dir(*file), []string{filepath.Base(*file)})
+	case *dir != "":
+		r.Pkg, err = loader.LoadDir(*dir)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+
+	failed := false
+	for _, expr := range flag.Args() {
+		info, err := r.Lookup(expr)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Error:", err)
+			failed = true
+			continue
+		}
+		r.Write(os.Stdout, info)
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,305 @@
+// Package complexcalc evalúa expresiones con números complejos, como
+// (1+2i)*conj(3-i) o sqrt(-4), con precisión complex64 o complex128.
+package complexcalc
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/cmplx"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Precision es el tipo con el que se guardan los resultados.
+type Precision int
+
+const (
+	Complex128 Precision = iota
+	Complex64
+)
+
+func (p Precision) String() string {
+	if p == Complex64 {
+		return "complex64"
+	}
+	return "complex128"
+}
+
+// ErrUnknownName se devuelve para variables o funciones que no existen.
+var ErrUnknownName = errors.New("complexcalc: nombre desconocido")
+
+// ErrNotQuadratic lo devuelve Quadratic cuando a es cero; la ecuación es
+// lineal y se resuelve con Linear.
+var ErrNotQuadratic = errors.New("complexcalc: a es cero, la ecuación no es cuadrática")
+
+// Calculator evalúa expresiones y recuerda las variables asignadas y el
+// último resultado en ans.
+type Calculator struct {
+	Precision Precision
+	vars      map[string]complex128
+}
+
+// New crea una calculadora con las constantes i, pi y e.
+func New(p Precision) *Calculator {
+	return &Calculator{Precision: p, vars: map[string]complex128{"ans": 0}}
+}
+
+var constants = map[string]complex128{
+	"i":  1i,
+	"pi": math.Pi,
+	"e":  math.E,
+}
+
+type function struct {
+	arity int
+	fn    func(args []complex128) complex128
+}
+
+func unary(f func(complex128) complex128) function {
+	return function{1, func(a []complex128) complex128 { return f(a[0]) }}
+}
+
+var functions = map[string]function{
+	"conj":  unary(cmplx.Conj),
+	"abs":   unary(func(z complex128) complex128 { return complex(cmplx.Abs(z), 0) }),
+	"arg":   unary(func(z complex128) complex128 { return complex(cmplx.Phase(z), 0) }),
+	"re":    unary(func(z complex128) complex128 { return complex(real(z), 0) }),
+	"im":    unary(func(z complex128) complex128 { return complex(imag(z), 0) }),
+	"exp":   unary(cmplx.Exp),
+	"log":   unary(cmplx.Log),
+	"log10": unary(cmplx.Log10),
+	"sqrt":  unary(cmplx.Sqrt),
+	"sin":   unary(cmplx.Sin),
+	"cos":   unary(cmplx.Cos),
+	"tan":   unary(cmplx.Tan),
+	"asin":  unary(cmplx.Asin),
+	"acos":  unary(cmplx.Acos),
+	"atan":  unary(cmplx.Atan),
+	"sinh":  unary(cmplx.Sinh),
+	"cosh":  unary(cmplx.Cosh),
+	"tanh":  unary(cmplx.Tanh),
+	"asinh": unary(cmplx.Asinh),
+	"acosh": unary(cmplx.Acosh),
+	"atanh": unary(cmplx.Atanh),
+	"cis":   unary(func(theta complex128) complex128 { return cmplx.Exp(1i * theta) }),
+	// rect(r, θ) convierte de forma polar a rectangular
+	"rect": {2, func(a []complex128) complex128 { return cmplx.Rect(real(a[0]), real(a[1])) }},
+}
+
+// Functions devuelve los nombres de las funciones disponibles.
+func Functions() []string {
+	names := make([]string, 0, len(functions))
+	for name := range functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Eval evalúa una línea. Acepta asignaciones como "z = 3+4i"; el resultado
+// queda además en ans.
+func (c *Calculator) Eval(line string) (complex128, error) {
+	name, expr := "", line
+	if lhs, rhs, ok := strings.Cut(line, "="); ok {
+		name, expr = strings.TrimSpace(lhs), rhs
+		if !validName(name) {
+			return 0, &SyntaxError{0, fmt.Sprintf("no se puede asignar a %q", name)}
+		}
+		if _, ok := constants[name]; ok {
+			return 0, fmt.Errorf("%s es una constante", name)
+		}
+	}
+
+	z, err := c.EvalExpr(expr)
+	if err != nil {
+		if se, ok := err.(*SyntaxError); ok && name != "" {
+			se.Pos += len(line) - len(expr)
+		}
+		return 0, err
+	}
+	c.vars["ans"] = z
+	if name != "" {
+		c.vars[name] = z
+	}
+	return z, nil
+}
+
+// EvalExpr evalúa una expresión sin efectos: no acepta asignaciones ni
+// cambia ans.
+func (c *Calculator) EvalExpr(expr string) (complex128, error) {
+	n, err := parse(expr)
+	if err != nil {
+		return 0, err
+	}
+	return n.eval(c)
+}
+
+func validName(s string) bool {
+	if s == "" || isDigit(s[0]) {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isIdentChar(s[i]) && !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Vars devuelve las variables definidas, incluida ans.
+func (c *Calculator) Vars() map[string]complex128 {
+	return c.vars
+}
+
+// round lleva z a la precisión elegida. Con complex64 se redondea después
+// de cada operación, igual que si el programa usara ese tipo.
+func (c *Calculator) round(z complex128) complex128 {
+	if c.Precision == Complex64 {
+		return complex128(complex64(z))
+	}
+	return z
+}
+
+func (n numberNode) eval(c *Calculator) (complex128, error) { return c.round(n.value), nil }
+
+func (n varNode) eval(c *Calculator) (complex128, error) {
+	if z, ok := c.vars[n.name]; ok {
+		return c.round(z), nil
+	}
+	if z, ok := constants[n.name]; ok {
+		return c.round(z), nil
+	}
+	return 0, fmt.Errorf("%w: %s (columna %d)", ErrUnknownName, n.name, n.pos+1)
+}
+
+func (n unaryNode) eval(c *Calculator) (complex128, error) {
+	x, err := n.x.eval(c)
+	// 0 - x en lugar de -x: así -4 queda como -4+0i y no -4-0i, que está
+	// del otro lado del corte de sqrt y log
+	return 0 - x, err
+}
+
+func (n binaryNode) eval(c *Calculator) (complex128, error) {
+	x, err := n.x.eval(c)
+	if err != nil {
+		return 0, err
+	}
+	y, err := n.y.eval(c)
+	if err != nil {
+		return 0, err
+	}
+	var z complex128
+	switch n.op {
+	case '+':
+		z = x + y
+	case '-':
+		z = x - y
+	case '*':
+		z = x * y
+	case '/':
+		z = x / y
+	case '^':
+		z = pow(x, y)
+	}
+	return c.round(z), nil
+}
+
+// maxIntPow es el mayor exponente entero que pow calcula multiplicando.
+const maxIntPow = 64
+
+// pow calcula x^y. Con exponentes enteros pequeños multiplica en lugar de
+// usar cmplx.Pow, que pasa por exp(y·log x) y deja restos como
+// (1+2i)^2 = -3 + 4.000000000000001i.
+func pow(x, y complex128) complex128 {
+	n := real(y)
+	if imag(y) != 0 || n != math.Trunc(n) || math.Abs(n) > maxIntPow {
+		return cmplx.Pow(x, y)
+	}
+	if n < 0 {
+		return 1 / intPow(x, int(-n))
+	}
+	return intPow(x, int(n))
+}
+
+// intPow calcula x^n por cuadrados sucesivos.
+func intPow(x complex128, n int) complex128 {
+	z := complex128(1)
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			z *= x
+		}
+		x *= x
+	}
+	return z
+}
+
+func (n callNode) eval(c *Calculator) (complex128, error) {
+	f, ok := functions[n.name]
+	if !ok {
+		return 0, fmt.Errorf("%w: función %s (columna %d)", ErrUnknownName, n.name, n.pos+1)
+	}
+	if len(n.args) != f.arity {
+		return 0, fmt.Errorf("%s recibe %d argumento(s), no %d", n.name, f.arity, len(n.args))
+	}
+	args := make([]complex128, len(n.args))
+	for i, a := range n.args {
+		var err error
+		if args[i], err = a.eval(c); err != nil {
+			return 0, err
+		}
+	}
+	return c.round(f.fn(args)), nil
+}
+
+// Format escribe z en forma rectangular (a+bi) o polar (r∠θ), con la
+// menor cantidad de dígitos que identifica el valor en la precisión dada.
+func (c *Calculator) Format(z complex128, polar bool) string {
+	bits := 64
+	if c.Precision == Complex64 {
+		bits = 32
+	}
+	num := func(f float64) string { return strconv.FormatFloat(f, 'g', -1, bits) }
+
+	if polar {
+		return num(cmplx.Abs(z)) + "∠" + num(cmplx.Phase(z))
+	}
+	re, im := real(z), imag(z)
+	switch {
+	case im == 0:
+		return num(re)
+	case re == 0:
+		return num(im) + "i"
+	case im < 0:
+		return num(re) + " - " + num(-im) + "i"
+	}
+	return num(re) + " + " + num(im) + "i"
+}
+
+// Quadratic resuelve a·x² + b·x + c = 0. Usa la fórmula que evita restar
+// números casi iguales, así la raíz pequeña no pierde precisión. Si a es
+// cero devuelve ErrNotQuadratic.
+func Quadratic(a, b, c complex128) (x1, x2 complex128, err error) {
+	if a == 0 {
+		return 0, 0, ErrNotQuadratic
+	}
+	d := cmplx.Sqrt(b*b - 4*a*c)
+	// Elige el signo que suma magnitudes en lugar de restarlas
+	if real(cmplx.Conj(b)*d) < 0 {
+		d = -d
+	}
+	q := -(b + d) / 2
+	if q == 0 {
+		return 0, 0, nil // b y c son cero
+	}
+	return q / a, c / q, nil
+}
+
+// Linear resuelve b·x + c = 0.
+func Linear(b, c complex128) (complex128, error) {
+	if b == 0 {
+		return 0, errors.New("complexcalc: no es una ecuación, a y b son cero")
+	}
+	return -c / b, nil
+}
@@ -0,0 +1,84 @@
+package complexcalc
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPowIntegerExact(t *testing.T) {
+	tests := []struct {
+		expr string
+		want complex128
+	}{
+		{"(1+2i)^2", -3 + 4i},
+		{"(1+2i)^3", -11 - 2i},
+		{"(1+i)^10", 32i},
+		{"i^4", 1},
+		{"(3-4i)^0", 1},
+		{"2^-2", 0.25},
+		{"(1+2i)^-1", 0.2 - 0.4i},
+	}
+	for _, tt := range tests {
+		got, err := New(Complex128).Eval(tt.expr)
+		if err != nil {
+			t.Errorf("Eval(%q): %v", tt.expr, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Eval(%q) = %v, se esperaba %v exacto", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestPowNonInteger(t *testing.T) {
+	got, err := New(Complex128).Eval("(-1)^0.5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := got - 1i; real(d)*real(d)+imag(d)*imag(d) > 1e-24 {
+		t.Errorf("(-1)^0.5 = %v, se esperaba i", got)
+	}
+}
+
+func TestQuadratic(t *testing.T) {
+	x1, x2, err := Quadratic(1, 2, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !(x1 == -1-2i && x2 == -1+2i) && !(x1 == -1+2i && x2 == -1-2i) {
+		t.Errorf("Quadratic(1, 2, 5) = %v, %v, se esperaba -1±2i", x1, x2)
+	}
+
+	if _, _, err := Quadratic(0, 2, 4); !errors.Is(err, ErrNotQuadratic) {
+		t.Errorf("Quadratic(0, 2, 4): error %v, se esperaba ErrNotQuadratic", err)
+	}
+}
+
+func TestLinear(t *testing.T) {
+	x, err := Linear(2, 4)
+	if err != nil || x != -2 {
+		t.Errorf("Linear(2, 4) = %v, %v, se esperaba -2", x, err)
+	}
+	if _, err := Linear(0, 1); err == nil {
+		t.Error("Linear(0, 1) no devolvió error")
+	}
+}
+
+func TestEvalExprHasNoEffects(t *testing.T) {
+	c := New(Complex128)
+	if _, err := c.Eval("x = 2"); err != nil {
+		t.Fatal(err)
+	}
+	if z, err := c.EvalExpr("x * 3"); err != nil || z != 6 {
+		t.Errorf("EvalExpr(\"x * 3\") = %v, %v, se esperaba 6", z, err)
+	}
+	if ans := c.Vars()["ans"]; ans != 2 {
+		t.Errorf("ans = %v después de EvalExpr, se esperaba 2", ans)
+	}
+	if _, err := c.EvalExpr("y = 1"); err == nil {
+		t.Error("EvalExpr aceptó una asignación")
+	}
+	if _, ok := c.Vars()["y"]; ok {
+		t.Error("EvalExpr definió la variable y")
+	}
+}
@@ -0,0 +1,266 @@
+package complexcalc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// SyntaxError indica dónde falla una expresión.
+type SyntaxError struct {
+	Pos int // Posición en bytes dentro de la expresión
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("columna %d: %s", e.Pos+1, e.Msg)
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokImag // Número seguido de i, ej. 2i o 1.5e3i
+	tokIdent
+	tokOp // + - * / ^ ( ) , =
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func tokenize(src string) ([]token, error) {
+	var toks []token
+	for i := 0; i < len(src); {
+		c := rune(src[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c >= '0' && c <= '9' || c == '.':
+			start := i
+			for i < len(src) && (isDigit(src[i]) || src[i] == '.') {
+				i++
+			}
+			// Exponente: 1e-3
+			if i < len(src) && (src[i] == 'e' || src[i] == 'E') {
+				j := i + 1
+				if j < len(src) && (src[j] == '+' || src[j] == '-') {
+					j++
+				}
+				if j < len(src) && isDigit(src[j]) {
+					for i = j; i < len(src) && isDigit(src[i]); i++ {
+					}
+				}
+			}
+			kind := tokNumber
+			text := src[start:i]
+			if i < len(src) && src[i] == 'i' && (i+1 == len(src) || !isIdentChar(src[i+1])) {
+				kind = tokImag
+				i++
+			}
+			toks = append(toks, token{kind, text, start})
+		case isIdentChar(src[i]):
+			start := i
+			for i < len(src) && (isIdentChar(src[i]) || isDigit(src[i])) {
+				i++
+			}
+			toks = append(toks, token{tokIdent, src[start:i], start})
+		case strings.ContainsRune("+-*/^(),=", c):
+			toks = append(toks, token{tokOp, string(c), i})
+			i++
+		default:
+			return nil, &SyntaxError{i, fmt.Sprintf("carácter inesperado %q", c)}
+		}
+	}
+	return append(toks, token{tokEOF, "", len(src)}), nil
+}
+
+func isDigit(c byte) bool     { return c >= '0' && c <= '9' }
+func isIdentChar(c byte) bool { return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' }
+
+// node es un nodo del árbol de la expresión.
+type node interface {
+	eval(c *Calculator) (complex128, error)
+}
+
+type (
+	numberNode struct{ value complex128 }
+	varNode    struct {
+		name string
+		pos  int
+	}
+	unaryNode  struct{ x node }
+	binaryNode struct {
+		op   byte
+		x, y node
+	}
+	callNode struct {
+		name string
+		args []node
+		pos  int
+	}
+)
+
+// parser es un analizador descendente recursivo. De menor a mayor
+// precedencia: + -, * /, signo, ^ (asociativo a la derecha).
+type parser struct {
+	toks []token
+	pos  int
+}
+
+func parse(src string) (node, error) {
+	toks, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks}
+	n, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, &SyntaxError{t.pos, fmt.Sprintf("sobra %q", t.text)}
+	}
+	return n, nil
+}
+
+func (p *parser) peek() token { return p.toks[p.pos] }
+
+func (p *parser) next() token {
+	t := p.toks[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isOp(op string) bool {
+	t := p.peek()
+	return t.kind == tokOp && t.text == op
+}
+
+func (p *parser) expect(op string) error {
+	if !p.isOp(op) {
+		t := p.peek()
+		if t.kind == tokEOF {
+			return &SyntaxError{t.pos, fmt.Sprintf("falta %q al final", op)}
+		}
+		return &SyntaxError{t.pos, fmt.Sprintf("se esperaba %q y llegó %q", op, t.text)}
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) expr() (node, error) {
+	x, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("+") || p.isOp("-") {
+		op := p.next().text[0]
+		y, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		x = binaryNode{op, x, y}
+	}
+	return x, nil
+}
+
+func (p *parser) term() (node, error) {
+	x, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("*") || p.isOp("/") {
+		op := p.next().text[0]
+		y, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		x = binaryNode{op, x, y}
+	}
+	return x, nil
+}
+
+func (p *parser) unary() (node, error) {
+	if p.isOp("-") {
+		p.next()
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return unaryNode{x}, nil
+	}
+	if p.isOp("+") {
+		p.next()
+		return p.unary()
+	}
+	return p.power()
+}
+
+func (p *parser) power() (node, error) {
+	x, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	if p.isOp("^") {
+		p.next()
+		// -2^2 es -(2^2), pero 2^-1 está permitido
+		y, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return binaryNode{'^', x, y}, nil
+	}
+	return x, nil
+}
+
+func (p *parser) primary() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokNumber, tokImag:
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, &SyntaxError{t.pos, fmt.Sprintf("número inválido %q", t.text)}
+		}
+		if t.kind == tokImag {
+			return numberNode{complex(0, f)}, nil
+		}
+		return numberNode{complex(f, 0)}, nil
+	case tokIdent:
+		if !p.isOp("(") {
+			return varNode{t.text, t.pos}, nil
+		}
+		p.next()
+		call := callNode{name: t.text, pos: t.pos}
+		if !p.isOp(")") {
+			for {
+				arg, err := p.expr()
+				if err != nil {
+					return nil, err
+				}
+				call.args = append(call.args, arg)
+				if !p.isOp(",") {
+					break
+				}
+				p.next()
+			}
+		}
+		return call, p.expect(")")
+	case tokOp:
+		if t.text == "(" {
+			x, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			return x, p.expect(")")
+		}
+		return nil, &SyntaxError{t.pos, fmt.Sprintf("operador %q fuera de lugar", t.text)}
+	}
+	return nil, &SyntaxError{t.pos, "expresión incompleta"}
+}
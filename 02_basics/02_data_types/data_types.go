@@ -179,10 +179,14 @@ func especiales() {
 	fmt.Println("Partes:", partes)
 
 	// Conversion de tipos
-	numero, err := strconv.Atoi("12345")              // string a int
+	numero, err := strconv.Atoi("12345") // string a int
+	if err != nil {
+		fmt.Println("Error al convertir:", err)
+		return
+	}
+
 	texto_num := strconv.Itoa(67890)                  // int a string
 	decimal, err := strconv.ParseFloat("3.14159", 64) // string a float64
-
 	if err != nil {
 		fmt.Println("Error al convertir:", err)
 		return
@@ -0,0 +1,208 @@
+// Package describe recorre cualquier valor con reflect y arma un árbol con
+// el nombre, el tipo y el valor de cada parte, y si es el valor cero.
+package describe
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Node es una parte de un valor.
+type Node struct {
+	Name     string  `json:"name,omitempty"` // Campo, [índice], [clave] o * para lo apuntado
+	Type     string  `json:"type"`
+	Kind     string  `json:"kind"`
+	Value    string  `json:"value,omitempty"` // Solo en las hojas
+	Zero     bool    `json:"zero"`
+	Cycle    string  `json:"cycle,omitempty"` // Ruta del ancestro al que vuelve
+	More     int     `json:"more,omitempty"`  // Elementos omitidos por MaxItems
+	Children []*Node `json:"children,omitempty"`
+}
+
+// Describer arma los árboles. El valor cero se puede usar directamente.
+type Describer struct {
+	MaxDepth int // 0 usa 10
+	MaxItems int // Elementos por slice, array o mapa; 0 usa 20
+}
+
+// Value describe v con las opciones por defecto.
+func Value(v any) *Node {
+	var d Describer
+	return d.Value(v)
+}
+
+// Value describe v.
+func (d *Describer) Value(v any) *Node {
+	w := walker{
+		maxDepth: or(d.MaxDepth, 10),
+		maxItems: or(d.MaxItems, 20),
+		visiting: make(map[visit]string),
+	}
+	return w.walk("", "", reflect.ValueOf(v), 0)
+}
+
+func or(v, def int) int {
+	if v <= 0 {
+		return def
+	}
+	return v
+}
+
+// visit identifica un puntero, mapa o slice por su dirección y su tipo; dos
+// tipos distintos pueden empezar en la misma dirección, ej. un struct y su
+// primer campo.
+type visit struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+type walker struct {
+	maxDepth, maxItems int
+	// visiting tiene las referencias del camino actual. Un ciclo es volver a
+	// una de ellas; ver la misma dos veces en ramas distintas no lo es.
+	visiting map[visit]string
+}
+
+func (w *walker) walk(name, path string, v reflect.Value, depth int) *Node {
+	if !v.IsValid() {
+		return &Node{Name: name, Type: "nil", Kind: "invalid", Value: "nil", Zero: true}
+	}
+	n := &Node{Name: name, Type: v.Type().String(), Kind: v.Kind().String(), Zero: v.IsZero()}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+		if v.IsNil() {
+			n.Value = "nil"
+			return n
+		}
+		key := visit{v.Pointer(), v.Type()}
+		if v.Kind() == reflect.Slice && v.Len() == 0 {
+			key = visit{} // Los slices vacíos pueden compartir dirección
+		}
+		if key.typ != nil {
+			if ancestor, ok := w.visiting[key]; ok {
+				n.Cycle = or2(ancestor, "(raíz)")
+				return n
+			}
+			w.visiting[key] = path
+			defer delete(w.visiting, key)
+		}
+	}
+
+	if depth >= w.maxDepth {
+		n.Value = "…"
+		return n
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		n.Children = []*Node{w.walk("*", path+"*", v.Elem(), depth+1)}
+	case reflect.Interface:
+		if v.IsNil() {
+			n.Value = "nil"
+			return n
+		}
+		// Se muestra el valor dinámico en lugar del nodo de la interfaz
+		child := w.walk(name, path, v.Elem(), depth)
+		child.Type = n.Type + " (" + child.Type + ")"
+		return child
+	case reflect.Struct:
+		t := v.Type()
+		for i := range v.NumField() {
+			f := t.Field(i)
+			n.Children = append(n.Children, w.walk(f.Name, path+"."+f.Name, v.Field(i), depth+1))
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range min(v.Len(), w.maxItems) {
+			index := "[" + strconv.Itoa(i) + "]"
+			n.Children = append(n.Children, w.walk(index, path+index, v.Index(i), depth+1))
+		}
+		n.More = v.Len() - len(n.Children)
+		if v.Len() == 0 {
+			n.Value = "[]"
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		labels := make([]string, len(keys))
+		order := make([]int, len(keys))
+		for i, k := range keys {
+			labels[i], order[i] = leaf(k), i
+		}
+		sort.Slice(order, func(a, b int) bool { return labels[order[a]] < labels[order[b]] })
+		for _, i := range order[:min(len(order), w.maxItems)] {
+			label := "[" + labels[i] + "]"
+			n.Children = append(n.Children, w.walk(label, path+label, v.MapIndex(keys[i]), depth+1))
+		}
+		n.More = len(keys) - len(n.Children)
+		if len(keys) == 0 {
+			n.Value = "map[]"
+		}
+	default:
+		n.Value = leaf(v)
+	}
+	return n
+}
+
+func or2(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
+
+// leaf escribe un valor simple. No usa v.Interface(), que falla con los
+// campos no exportados.
+func leaf(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits())
+	case reflect.Complex64, reflect.Complex128:
+		return strconv.FormatComplex(v.Complex(), 'g', -1, v.Type().Bits())
+	case reflect.String:
+		return strconv.Quote(v.String())
+	case reflect.Chan:
+		if v.IsNil() {
+			return "nil"
+		}
+		return fmt.Sprintf("%#x (len %d, cap %d)", v.Pointer(), v.Len(), v.Cap())
+	case reflect.Func, reflect.UnsafePointer:
+		if v.IsNil() {
+			return "nil"
+		}
+		return fmt.Sprintf("%#x", v.Pointer())
+	case reflect.Pointer, reflect.Map, reflect.Slice:
+		if v.IsNil() {
+			return "nil"
+		}
+		return fmt.Sprintf("%#x", v.Pointer())
+	case reflect.Interface:
+		// Claves de map[any]T: se escribe el valor dinámico
+		if v.IsNil() {
+			return "nil"
+		}
+		return leaf(v.Elem())
+	case reflect.Struct:
+		// Solo llega aquí como clave de mapa
+		parts := make([]string, v.NumField())
+		for i := range parts {
+			parts[i] = leaf(v.Field(i))
+		}
+		return "{" + strings.Join(parts, " ") + "}"
+	case reflect.Array:
+		parts := make([]string, v.Len())
+		for i := range parts {
+			parts[i] = leaf(v.Index(i))
+		}
+		return "[" + strings.Join(parts, " ") + "]"
+	}
+	return v.String()
+}
@@ -0,0 +1,153 @@
+package describe_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/FepDev25/gobootcamp/02_basics/02_data_types/describe"
+)
+
+type point struct {
+	x, y int
+}
+
+type person struct {
+	Name  string
+	age   int
+	Tags  []string
+	inner *person
+}
+
+type list struct {
+	Val  int
+	Next *list
+}
+
+func tree(t *testing.T, v any) string {
+	t.Helper()
+	var b strings.Builder
+	if err := describe.Value(v).WriteTree(&b); err != nil {
+		t.Fatal(err)
+	}
+	return b.String()
+}
+
+func TestWriteTree(t *testing.T) {
+	tests := []struct {
+		name string
+		v    any
+		want string
+	}{
+		{"claves interfaz", map[any]int{"a": 1, 2: 3, nil: 0}, `map[interface {}]int
+├── ["a"] int = 1
+├── [2] int = 3
+└── [nil] int = 0 (cero)
+`},
+		{"claves struct", map[point]string{{1, 2}: "p"}, `map[describe_test.point]string
+└── [{1 2}] string = "p"
+`},
+		{"claves array", map[[2]int]bool{{3, 4}: true}, `map[[2]int]bool
+└── [[3 4]] bool = true
+`},
+		{"campos no exportados", person{Name: "Ana", age: 30}, `describe_test.person
+├── Name string = "Ana"
+├── age int = 30
+├── Tags []string = nil (cero)
+└── inner *describe_test.person = nil (cero)
+`},
+		{"nil", nil, "nil = nil (cero)\n"},
+	}
+	for _, tt := range tests {
+		if got := tree(t, tt.v); got != tt.want {
+			t.Errorf("%s:\n%s\nse esperaba:\n%s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCycles(t *testing.T) {
+	a := &list{Val: 1}
+	a.Next = &list{Val: 2, Next: a}
+
+	s := []any{1, nil}
+	s[1] = s
+
+	m := map[string]any{}
+	m["self"] = m
+
+	p := &person{Name: "yo"}
+	p.inner = p
+
+	tests := []struct {
+		name string
+		v    any
+		want string // Línea con la marca de ciclo
+	}{
+		{"puntero", a, "Next *describe_test.list ↺ ciclo hacia (raíz)"},
+		{"slice", s, "[1] interface {} ([]interface {}) ↺ ciclo hacia (raíz)"},
+		{"mapa", m, `["self"] interface {} (map[string]interface {}) ↺ ciclo hacia (raíz)`},
+		{"campo no exportado", p, "inner *describe_test.person ↺ ciclo hacia (raíz)"},
+	}
+	for _, tt := range tests {
+		got := tree(t, tt.v)
+		if !strings.Contains(got, tt.want) {
+			t.Errorf("%s: falta %q en:\n%s", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestCyclePath(t *testing.T) {
+	// El ciclo no vuelve a la raíz sino a un nodo intermedio
+	b := &list{Val: 2}
+	b.Next = &list{Val: 3, Next: b}
+	root := struct{ Head *list }{&list{Val: 1, Next: b}}
+
+	got := tree(t, root)
+	if !strings.Contains(got, "↺ ciclo hacia .Head*.Next") {
+		t.Errorf("se esperaba un ciclo hacia .Head*.Next en:\n%s", got)
+	}
+}
+
+func TestSharedIsNotCycle(t *testing.T) {
+	shared := &list{Val: 1}
+	got := tree(t, []*list{shared, shared})
+	if strings.Contains(got, "ciclo") {
+		t.Errorf("la misma referencia en dos ramas no es un ciclo:\n%s", got)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	d := &describe.Describer{MaxItems: 2, MaxDepth: 1}
+	n := d.Value([]int{1, 2, 3, 4})
+	if len(n.Children) != 2 || n.More != 2 {
+		t.Errorf("%d hijos y %d omitidos, se esperaba 2 y 2", len(n.Children), n.More)
+	}
+
+	n = d.Value([][]int{{1}})
+	if v := n.Children[0].Value; v != "…" {
+		t.Errorf("más allá de MaxDepth el valor es %q, se esperaba …", v)
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := describe.Value(map[any]point{"p": {1, 0}}).WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var got describe.Node
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("JSON inválido: %v\n%s", err, buf.Bytes())
+	}
+	if got.Kind != "map" || len(got.Children) != 1 {
+		t.Fatalf("raíz %+v, se esperaba un mapa con un hijo", got)
+	}
+	p := got.Children[0]
+	if p.Name != `["p"]` || p.Type != "describe_test.point" || len(p.Children) != 2 {
+		t.Fatalf("hijo %+v, se esperaba [\"p\"] de tipo point con dos campos", p)
+	}
+	y := p.Children[1]
+	if y.Name != "y" || y.Value != "0" || !y.Zero {
+		t.Errorf("campo y = %+v, se esperaba 0 y cero", y)
+	}
+}
@@ -0,0 +1,54 @@
+package describe
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteTree escribe el árbol con una línea por nodo. Los valores cero se
+// marcan con (cero).
+func (n *Node) WriteTree(w io.Writer) error {
+	var b strings.Builder
+	n.write(&b, "", "")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (n *Node) write(b *strings.Builder, prefix, childPrefix string) {
+	b.WriteString(prefix)
+	if n.Name != "" {
+		b.WriteString(n.Name + " ")
+	}
+	b.WriteString(n.Type)
+	if n.Value != "" {
+		b.WriteString(" = " + n.Value)
+	}
+	if n.Cycle != "" {
+		b.WriteString(" ↺ ciclo hacia " + n.Cycle)
+	}
+	if n.Zero {
+		b.WriteString(" (cero)")
+	}
+	b.WriteString("\n")
+
+	for i, c := range n.Children {
+		last := i == len(n.Children)-1 && n.More == 0
+		branch, indent := "├── ", "│   "
+		if last {
+			branch, indent = "└── ", "    "
+		}
+		c.write(b, childPrefix+branch, childPrefix+indent)
+	}
+	if n.More > 0 {
+		fmt.Fprintf(b, "%s└── … %d más\n", childPrefix, n.More)
+	}
+}
+
+// WriteJSON escribe el árbol como JSON con sangría.
+func (n *Node) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(n)
+}
@@ -0,0 +1,138 @@
+// Package dispatch generaliza el switch de tipos: en lugar de escribir cada
+// case, se registra un manejador por tipo y se elige en tiempo de ejecución.
+//
+// Para un valor v se busca, en orden:
+//  1. un manejador del tipo exacto de v;
+//  2. el primero registrado para una interfaz que v implemente;
+//  3. el manejador por defecto.
+package dispatch
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Registry guarda los manejadores que devuelven un R. Se puede usar desde
+// varias goroutines a la vez. El valor cero es un registro vacío listo para
+// usarse.
+type Registry[R any] struct {
+	mu         sync.RWMutex
+	exact      map[reflect.Type]func(any) R
+	interfaces []ifaceHandler[R] // En orden de registro
+	fallback   func(any) R
+
+	// resolved recuerda qué manejador de interfaz le tocó a cada tipo; se
+	// vacía con cada Register porque el orden puede cambiar
+	resolved map[reflect.Type]func(any) R
+}
+
+type ifaceHandler[R any] struct {
+	typ reflect.Type
+	fn  func(any) R
+}
+
+// New crea un registro vacío.
+func New[R any]() *Registry[R] {
+	return &Registry[R]{
+		exact:    make(map[reflect.Type]func(any) R),
+		resolved: make(map[reflect.Type]func(any) R),
+	}
+}
+
+// Register asocia h al tipo T. Si T es una interfaz, h atiende a todos los
+// tipos que la implementen y no tengan un manejador propio. Registrar dos
+// veces el mismo T reemplaza el anterior.
+//
+// Ej: dispatch.Register(r, func(n int) string { return "entero" })
+func Register[T, R any](r *Registry[R], h func(T) R) {
+	t := reflect.TypeFor[T]()
+	fn := func(v any) R { return h(v.(T)) }
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clear(r.resolved)
+	if t.Kind() != reflect.Interface {
+		if r.exact == nil {
+			r.exact = make(map[reflect.Type]func(any) R)
+		}
+		r.exact[t] = fn
+		return
+	}
+	for i, ih := range r.interfaces {
+		if ih.typ == t {
+			r.interfaces[i].fn = fn
+			return
+		}
+	}
+	r.interfaces = append(r.interfaces, ifaceHandler[R]{t, fn})
+}
+
+// SetDefault define el manejador para los valores sin otro manejador,
+// incluido nil.
+func (r *Registry[R]) SetDefault(h func(any) R) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fallback = h
+}
+
+// Dispatch llama al manejador que corresponde a v. Devuelve false si no hay
+// ninguno, ni siquiera por defecto.
+func (r *Registry[R]) Dispatch(v any) (R, bool) {
+	if fn := r.lookup(reflect.TypeOf(v)); fn != nil {
+		return fn(v), true
+	}
+	var zero R
+	return zero, false
+}
+
+// Handles indica si v tiene un manejador propio o de interfaz, sin contar
+// el por defecto.
+func (r *Registry[R]) Handles(v any) bool {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if _, ok := r.exact[t]; ok {
+		return true
+	}
+	for _, ih := range r.interfaces {
+		if t.Implements(ih.typ) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Registry[R]) lookup(t reflect.Type) func(any) R {
+	if t == nil {
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+		return r.fallback
+	}
+
+	r.mu.RLock()
+	if fn, ok := r.exact[t]; ok {
+		r.mu.RUnlock()
+		return fn
+	}
+	if fn, ok := r.resolved[t]; ok {
+		r.mu.RUnlock()
+		return fn
+	}
+	r.mu.RUnlock()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, ih := range r.interfaces {
+		if t.Implements(ih.typ) {
+			if r.resolved == nil {
+				r.resolved = make(map[reflect.Type]func(any) R)
+			}
+			r.resolved[t] = ih.fn
+			return ih.fn
+		}
+	}
+	return r.fallback
+}
@@ -0,0 +1,140 @@
+package dispatch_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/FepDev25/gobootcamp/02_basics/02_data_types/dispatch"
+)
+
+type celsius float64
+
+func (c celsius) String() string { return strconv.FormatFloat(float64(c), 'f', 1, 64) + "°C" }
+
+type code int
+
+func (c code) String() string { return "código " + strconv.Itoa(int(c)) }
+func (c code) Error() string  { return "error " + strconv.Itoa(int(c)) }
+
+func registry() *dispatch.Registry[string] {
+	r := dispatch.New[string]()
+	dispatch.Register(r, func(n int) string { return "int" })
+	dispatch.Register(r, func(c celsius) string { return "celsius " + c.String() })
+	dispatch.Register(r, func(s fmt.Stringer) string { return "stringer " + s.String() })
+	dispatch.Register(r, func(err error) string { return "error " + err.Error() })
+	r.SetDefault(func(v any) string { return fmt.Sprintf("default %v", v) })
+	return r
+}
+
+func TestPrecedence(t *testing.T) {
+	r := registry()
+	tests := []struct {
+		name string
+		v    any
+		want string
+	}{
+		{"tipo exacto", 42, "int"},
+		{"exacto antes que interfaz", celsius(21.5), "celsius 21.5°C"},
+		{"primera interfaz registrada", code(7), "stringer código 7"},
+		{"segunda interfaz", errors.New("x"), "error x"},
+		{"por defecto", 3.5, "default 3.5"},
+		{"nil sin tipo", nil, "default <nil>"},
+	}
+	for _, tt := range tests {
+		got, ok := r.Dispatch(tt.v)
+		if !ok || got != tt.want {
+			t.Errorf("%s: Dispatch(%#v) = %q, %v, se esperaba %q", tt.name, tt.v, got, ok, tt.want)
+		}
+	}
+}
+
+func TestTypedNil(t *testing.T) {
+	r := dispatch.New[string]()
+	dispatch.Register(r, func(p *int) string {
+		if p == nil {
+			return "puntero nil"
+		}
+		return "puntero"
+	})
+	// Un nil con tipo tiene manejador propio; el nil sin tipo no
+	if got, ok := r.Dispatch((*int)(nil)); !ok || got != "puntero nil" {
+		t.Errorf("Dispatch((*int)(nil)) = %q, %v, se esperaba \"puntero nil\"", got, ok)
+	}
+	if got, ok := r.Dispatch(nil); ok {
+		t.Errorf("Dispatch(nil) = %q sin manejador por defecto, se esperaba false", got)
+	}
+	if r.Handles(nil) {
+		t.Error("Handles(nil) = true, se esperaba false")
+	}
+	if !r.Handles((*int)(nil)) {
+		t.Error("Handles((*int)(nil)) = false, se esperaba true")
+	}
+}
+
+func TestReregisterClearsCache(t *testing.T) {
+	r := dispatch.New[string]()
+	dispatch.Register(r, func(s fmt.Stringer) string { return "v1" })
+	if got, _ := r.Dispatch(code(1)); got != "v1" {
+		t.Fatalf("Dispatch = %q, se esperaba v1", got)
+	}
+	// code quedó resuelto a v1; el reemplazo tiene que verse igual
+	dispatch.Register(r, func(s fmt.Stringer) string { return "v2" })
+	if got, _ := r.Dispatch(code(1)); got != "v2" {
+		t.Errorf("después de registrar de nuevo Dispatch = %q, se esperaba v2", got)
+	}
+	// Un manejador exacto nuevo gana sobre la interfaz ya resuelta
+	dispatch.Register(r, func(c code) string { return "exacto" })
+	if got, _ := r.Dispatch(code(1)); got != "exacto" {
+		t.Errorf("con manejador exacto Dispatch = %q, se esperaba exacto", got)
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	var r dispatch.Registry[string]
+	if _, ok := r.Dispatch(1); ok {
+		t.Error("un registro vacío despachó un valor")
+	}
+	dispatch.Register(&r, func(n int) string { return "int" })
+	dispatch.Register(&r, func(s fmt.Stringer) string { return "stringer" })
+	if got, ok := r.Dispatch(1); !ok || got != "int" {
+		t.Errorf("Dispatch(1) = %q, %v, se esperaba int", got, ok)
+	}
+	if got, ok := r.Dispatch(code(1)); !ok || got != "stringer" {
+		t.Errorf("Dispatch(code(1)) = %q, %v, se esperaba stringer", got, ok)
+	}
+}
+
+// Se ejecuta con go test -race para detectar accesos sin sincronizar.
+func TestConcurrent(t *testing.T) {
+	r := registry()
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := range 200 {
+				if i%2 == 0 {
+					dispatch.Register(r, func(s fmt.Stringer) string { return "stringer " + s.String() })
+				} else {
+					dispatch.Register(r, func(b bool) string { return "bool" })
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := range 200 {
+				var v any = code(g*1000 + i)
+				got, ok := r.Dispatch(v)
+				if want := "stringer " + v.(code).String(); !ok || got != want {
+					t.Errorf("Dispatch(%v) = %q, %v, se esperaba %q", v, got, ok, want)
+					return
+				}
+				r.Handles(celsius(i))
+			}
+		}()
+	}
+	wg.Wait()
+}
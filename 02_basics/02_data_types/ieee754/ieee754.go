@@ -0,0 +1,215 @@
+// Package ieee754 muestra lo que realmente guarda un float32 o un float64:
+// los bits de signo, exponente y mantisa, el valor decimal exacto y cuánto se
+// aleja del literal escrito en el código.
+package ieee754
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Format describe un formato binario de IEEE-754.
+type Format struct {
+	Name     string
+	Bits     int
+	ExpBits  int
+	MantBits int // Sin contar el 1 implícito
+	Bias     int
+}
+
+var (
+	Float32 = Format{Name: "float32", Bits: 32, ExpBits: 8, MantBits: 23, Bias: 127}
+	Float64 = Format{Name: "float64", Bits: 64, ExpBits: 11, MantBits: 52, Bias: 1023}
+)
+
+// Class es la categoría de un valor.
+type Class int
+
+const (
+	Zero Class = iota
+	Subnormal
+	Normal
+	Inf
+	NaN
+)
+
+func (c Class) String() string {
+	return [...]string{"cero", "subnormal", "normal", "infinito", "NaN"}[c]
+}
+
+// ErrSyntax se devuelve cuando el literal no es un número válido.
+var ErrSyntax = errors.New("ieee754: literal inválido")
+
+// Value es un número descompuesto.
+type Value struct {
+	Literal string // Texto original; vacío si se creó a partir de los bits
+	Format  Format
+	Bits    uint64
+	Class   Class
+
+	Sign     uint64
+	Exponent uint64 // Tal como está guardado, con el sesgo
+	Mantissa uint64 // Sin el 1 implícito
+
+	Float float64  // Valor guardado; un float32 se amplía sin pérdida
+	Exact *big.Rat // nil para Inf y NaN
+
+	// Error es guardado - literal; nil si no hay literal o no es finito.
+	Error *big.Rat
+}
+
+// Parse convierte literal al formato f con el mismo redondeo que el
+// compilador, al más cercano con empates al par.
+func Parse(literal string, f Format) (*Value, error) {
+	text := strings.ReplaceAll(strings.TrimSpace(literal), "_", "")
+	x, err := strconv.ParseFloat(text, f.Bits)
+	if err != nil && !errors.Is(err, strconv.ErrRange) {
+		return nil, fmt.Errorf("%w: %q", ErrSyntax, literal)
+	}
+
+	v := FromFloat(x, f)
+	v.Literal = literal
+	if want, ok := new(big.Rat).SetString(text); ok && v.Exact != nil {
+		v.Error = new(big.Rat).Sub(v.Exact, want)
+	}
+	return v, nil
+}
+
+// FromFloat descompone x en el formato f. Con Float32, x se redondea antes.
+func FromFloat(x float64, f Format) *Value {
+	if f.Bits == 32 {
+		return FromBits(uint64(math.Float32bits(float32(x))), f)
+	}
+	return FromBits(math.Float64bits(x), f)
+}
+
+// FromBits interpreta bits en el formato f.
+func FromBits(bits uint64, f Format) *Value {
+	v := &Value{Format: f, Bits: bits}
+	v.Sign = bits >> (f.Bits - 1)
+	v.Exponent = bits >> f.MantBits & (1<<f.ExpBits - 1)
+	v.Mantissa = bits & (1<<f.MantBits - 1)
+
+	if f.Bits == 32 {
+		v.Float = float64(math.Float32frombits(uint32(bits)))
+	} else {
+		v.Float = math.Float64frombits(bits)
+	}
+
+	maxExp := uint64(1<<f.ExpBits - 1)
+	switch {
+	case v.Exponent == maxExp && v.Mantissa == 0:
+		v.Class = Inf
+	case v.Exponent == maxExp:
+		v.Class = NaN
+	case v.Exponent == 0 && v.Mantissa == 0:
+		v.Class = Zero
+	case v.Exponent == 0:
+		v.Class = Subnormal
+	default:
+		v.Class = Normal
+	}
+	if v.Class != Inf && v.Class != NaN {
+		v.Exact = new(big.Rat).SetFloat64(v.Float)
+	}
+	return v
+}
+
+// Unbiased devuelve el exponente real. Los subnormales usan el del menor
+// normal.
+func (v *Value) Unbiased() int {
+	if v.Exponent == 0 {
+		return 1 - v.Format.Bias
+	}
+	return int(v.Exponent) - v.Format.Bias
+}
+
+// Significand devuelve la mantisa como número entre 0 y 2, con el 1
+// implícito si el valor es normal.
+func (v *Value) Significand() float64 {
+	s := float64(v.Mantissa) / float64(uint64(1)<<v.Format.MantBits)
+	if v.Class == Normal {
+		s++
+	}
+	return s
+}
+
+// Next devuelve el siguiente valor representable hacia +Inf.
+func (v *Value) Next() *Value { return v.toward(math.Inf(1)) }
+
+// Prev devuelve el valor representable anterior, hacia -Inf.
+func (v *Value) Prev() *Value { return v.toward(math.Inf(-1)) }
+
+func (v *Value) toward(y float64) *Value {
+	if v.Format.Bits == 32 {
+		return FromFloat(float64(math.Nextafter32(float32(v.Float), float32(y))), v.Format)
+	}
+	return FromFloat(math.Nextafter(v.Float, y), v.Format)
+}
+
+// ULP devuelve la distancia al siguiente valor representable en magnitud,
+// es decir, cuánto vale el último bit de la mantisa. nil si no es finito.
+func (v *Value) ULP() *big.Rat {
+	if v.Exact == nil {
+		return nil
+	}
+	exp := v.Unbiased() - v.Format.MantBits
+	if exp >= 0 {
+		return new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), uint(exp)))
+	}
+	return new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Lsh(big.NewInt(1), uint(-exp)))
+}
+
+// ErrorULPs devuelve el error de redondeo medido en ULPs. Nunca pasa de 0,5
+// para un literal dentro del rango.
+func (v *Value) ErrorULPs() float64 {
+	if v.Error == nil {
+		return 0
+	}
+	f, _ := new(big.Rat).Quo(v.Error, v.ULP()).Float64()
+	return f
+}
+
+// Decimal escribe r completo: toda fracción binaria tiene un desarrollo
+// decimal finito.
+func Decimal(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	// Con denominador 2^k hacen falta exactamente k decimales
+	digits := r.Denom().BitLen() - 1
+	return strings.TrimRight(r.FloatString(digits), "0")
+}
+
+// Specials devuelve ejemplos de los valores especiales del formato f.
+func Specials(f Format) []Special {
+	sign := uint64(1) << (f.Bits - 1)
+	expMask := uint64(1<<f.ExpBits-1) << f.MantBits
+	quiet := uint64(1) << (f.MantBits - 1)
+	maxMant := uint64(1)<<f.MantBits - 1
+
+	return []Special{
+		{"cero positivo", FromBits(0, f)},
+		{"cero negativo (== 0, pero 1/x da -Inf)", FromBits(sign, f)},
+		{"menor subnormal", FromBits(1, f)},
+		{"mayor subnormal", FromBits(maxMant, f)},
+		{"menor normal", FromBits(1<<f.MantBits, f)},
+		{"uno", FromFloat(1, f)},
+		{"mayor finito", FromBits(expMask-1, f)},
+		{"+Inf", FromBits(expMask, f)},
+		{"-Inf", FromBits(sign|expMask, f)},
+		{"NaN silencioso (el de math.NaN)", FromBits(expMask|quiet, f)},
+		{"NaN silencioso con carga útil 0x2a", FromBits(expMask|quiet|0x2a, f)},
+		{"NaN señalizador (bit más alto de la mantisa en 0)", FromBits(expMask|1, f)},
+	}
+}
+
+// Special es un valor especial con su descripción.
+type Special struct {
+	Name  string
+	Value *Value
+}
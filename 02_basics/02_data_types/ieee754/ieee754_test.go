@@ -0,0 +1,253 @@
+package ieee754
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestFields(t *testing.T) {
+	tests := []struct {
+		literal  string
+		f        Format
+		bits     uint64
+		sign     uint64
+		exponent uint64
+		mantissa uint64
+		class    Class
+	}{
+		{"1", Float64, 0x3FF0000000000000, 0, 1023, 0, Normal},
+		{"-2.5", Float64, 0xC004000000000000, 1, 1024, 1 << 50, Normal},
+		{"0.1", Float64, 0x3FB999999999999A, 0, 1019, 0x999999999999A, Normal},
+		{"0.1", Float32, 0x3DCCCCCD, 0, 123, 0x4CCCCD, Normal},
+		{"1_000", Float32, 0x447A0000, 0, 136, 0x7A0000, Normal},
+		{"0", Float64, 0, 0, 0, 0, Zero},
+		{"-0", Float64, 1 << 63, 1, 0, 0, Zero},
+		{"-0", Float32, 1 << 31, 1, 0, 0, Zero},
+		{"5e-324", Float64, 1, 0, 0, 1, Subnormal},
+		{"1e-45", Float32, 1, 0, 0, 1, Subnormal},
+		{"1e400", Float64, 0x7FF0000000000000, 0, 2047, 0, Inf},
+		{"-1e39", Float32, 0xFF800000, 1, 255, 0, Inf},
+		{"-Inf", Float64, 0xFFF0000000000000, 1, 2047, 0, Inf},
+	}
+	for _, tt := range tests {
+		v, err := Parse(tt.literal, tt.f)
+		if err != nil {
+			t.Errorf("Parse(%q, %s): %v", tt.literal, tt.f.Name, err)
+			continue
+		}
+		if v.Bits != tt.bits || v.Sign != tt.sign || v.Exponent != tt.exponent || v.Mantissa != tt.mantissa || v.Class != tt.class {
+			t.Errorf("Parse(%q, %s) = bits %#x, signo %d, exponente %d, mantisa %#x, %s; se esperaba %#x, %d, %d, %#x, %s",
+				tt.literal, tt.f.Name, v.Bits, v.Sign, v.Exponent, v.Mantissa, v.Class,
+				tt.bits, tt.sign, tt.exponent, tt.mantissa, tt.class)
+		}
+	}
+}
+
+func TestNaN(t *testing.T) {
+	for _, f := range []Format{Float32, Float64} {
+		v, err := Parse("NaN", f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.Class != NaN || !math.IsNaN(v.Float) || v.Exact != nil || v.Error != nil || v.ULP() != nil {
+			t.Errorf("%s: NaN descompuesto como %+v", f.Name, v)
+		}
+		if n := v.Next(); n.Class != NaN {
+			t.Errorf("%s: Next(NaN) es %s", f.Name, n.Class)
+		}
+	}
+}
+
+func TestUnbiasedSignificand(t *testing.T) {
+	tests := []struct {
+		x           float64
+		f           Format
+		unbiased    int
+		significand float64
+	}{
+		{1, Float64, 0, 1},
+		{-2.5, Float64, 1, 1.25},
+		{0.75, Float32, -1, 1.5},
+		// Los subnormales usan el exponente del menor normal y no tienen el 1
+		{math.SmallestNonzeroFloat64, Float64, -1022, 0x1p-52},
+		{0, Float32, -126, 0},
+	}
+	for _, tt := range tests {
+		v := FromFloat(tt.x, tt.f)
+		if v.Unbiased() != tt.unbiased || v.Significand() != tt.significand {
+			t.Errorf("%v en %s: exponente %d y mantisa %v, se esperaba %d y %v",
+				tt.x, tt.f.Name, v.Unbiased(), v.Significand(), tt.unbiased, tt.significand)
+		}
+	}
+}
+
+func TestNextPrev(t *testing.T) {
+	tests := []struct {
+		name       string
+		v          *Value
+		next, prev uint64
+	}{
+		{"1 float64", FromFloat(1, Float64), 0x3FF0000000000001, 0x3FEFFFFFFFFFFFFF},
+		{"1 float32", FromFloat(1, Float32), 0x3F800001, 0x3F7FFFFF},
+		{"+0", FromBits(0, Float64), 1, 1<<63 | 1},
+		{"-0", FromBits(1<<31, Float32), 1, 1<<31 | 1},
+		{"mayor subnormal", FromBits(1<<52-1, Float64), 1 << 52, 1<<52 - 2},
+		{"mayor finito", FromFloat(math.MaxFloat32, Float32), 0x7F800000, 0x7F7FFFFE},
+		{"+Inf", FromFloat(math.Inf(1), Float64), 0x7FF0000000000000, 0x7FEFFFFFFFFFFFFF},
+		{"-Inf", FromFloat(math.Inf(-1), Float32), 0xFF7FFFFF, 0xFF800000},
+	}
+	for _, tt := range tests {
+		if got := tt.v.Next().Bits; got != tt.next {
+			t.Errorf("Next(%s) = %#x, se esperaba %#x", tt.name, got, tt.next)
+		}
+		if got := tt.v.Prev().Bits; got != tt.prev {
+			t.Errorf("Prev(%s) = %#x, se esperaba %#x", tt.name, got, tt.prev)
+		}
+	}
+}
+
+func pow2(exp int) *big.Rat {
+	if exp >= 0 {
+		return new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), uint(exp)))
+	}
+	return new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Lsh(big.NewInt(1), uint(-exp)))
+}
+
+func TestULP(t *testing.T) {
+	tests := []struct {
+		x   float64
+		f   Format
+		exp int // ULP = 2^exp
+	}{
+		{1, Float64, -52},
+		{1, Float32, -23},
+		{-1.5, Float64, -52},
+		{0x1p53, Float64, 1},
+		{0x1p24, Float32, 1},
+		{0, Float64, -1074},
+		{math.SmallestNonzeroFloat32, Float32, -149},
+		{math.MaxFloat64, Float64, 971},
+	}
+	for _, tt := range tests {
+		v := FromFloat(tt.x, tt.f)
+		if got, want := v.ULP(), pow2(tt.exp); got.Cmp(want) != 0 {
+			t.Errorf("ULP(%v) en %s = %s, se esperaba 2^%d", tt.x, tt.f.Name, got, tt.exp)
+		}
+		// El siguiente en magnitud está exactamente a un ULP
+		next := v.Next()
+		if v.Sign == 1 {
+			next = v.Prev()
+		}
+		if next.Exact == nil {
+			continue
+		}
+		if d := new(big.Rat).Sub(next.Exact, v.Exact); d.Abs(d).Cmp(v.ULP()) != 0 {
+			t.Errorf("%v en %s: el siguiente está a %s, se esperaba un ULP", tt.x, tt.f.Name, d)
+		}
+	}
+	for _, x := range []float64{math.Inf(1), math.Inf(-1), math.NaN()} {
+		if u := FromFloat(x, Float64).ULP(); u != nil {
+			t.Errorf("ULP(%v) = %s, se esperaba nil", x, u)
+		}
+	}
+}
+
+func TestRoundingError(t *testing.T) {
+	tests := []struct {
+		literal string
+		f       Format
+		sign    int // Signo de guardado - literal
+	}{
+		{"0.5", Float64, 0},
+		{"0.1", Float64, 1},
+		{"0.1", Float32, 1},
+		{"0.3", Float64, -1},
+		{"16777217", Float32, -1}, // 2^24 + 1 empata y va al par
+		{"1e-45", Float32, 1},
+	}
+	for _, tt := range tests {
+		v, err := Parse(tt.literal, tt.f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.Error == nil || v.Error.Sign() != tt.sign {
+			t.Errorf("Parse(%q, %s): error de redondeo %v, se esperaba signo %d", tt.literal, tt.f.Name, v.Error, tt.sign)
+			continue
+		}
+		if u := v.ErrorULPs(); math.Abs(u) > 0.5 {
+			t.Errorf("Parse(%q, %s): error de %v ULPs, no puede pasar de 0,5", tt.literal, tt.f.Name, u)
+		}
+	}
+	if v, _ := Parse("1e400", Float64); v.Error != nil || v.ErrorULPs() != 0 {
+		t.Errorf("Parse(\"1e400\"): error de redondeo %v para un infinito", v.Error)
+	}
+}
+
+func TestParseSyntax(t *testing.T) {
+	for _, literal := range []string{"", "abc", "1..2", "0x", "1e"} {
+		if _, err := Parse(literal, Float64); !errors.Is(err, ErrSyntax) {
+			t.Errorf("Parse(%q): error %v, se esperaba ErrSyntax", literal, err)
+		}
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		x    float64
+		f    Format
+		want string
+	}{
+		{0.1, Float64, "0.1000000000000000055511151231257827021181583404541015625"},
+		{0.1, Float32, "0.100000001490116119384765625"},
+		{0.5, Float64, "0.5"},
+		{-0.25, Float64, "-0.25"},
+		{0x1p53, Float64, "9007199254740992"},
+		{0, Float64, "0"},
+		{0x1p-10, Float32, "0.0009765625"},
+	}
+	for _, tt := range tests {
+		if got := Decimal(FromFloat(tt.x, tt.f).Exact); got != tt.want {
+			t.Errorf("Decimal(%v en %s) = %s, se esperaba %s", tt.x, tt.f.Name, got, tt.want)
+		}
+	}
+}
+
+func TestSpecials(t *testing.T) {
+	want := []Class{Zero, Zero, Subnormal, Subnormal, Normal, Normal, Normal, Inf, Inf, NaN, NaN, NaN}
+	for _, f := range []Format{Float32, Float64} {
+		specials := Specials(f)
+		if len(specials) != len(want) {
+			t.Fatalf("%s: %d valores especiales, se esperaban %d", f.Name, len(specials), len(want))
+		}
+		for i, s := range specials {
+			if s.Value.Class != want[i] {
+				t.Errorf("%s: %s es %s, se esperaba %s", f.Name, s.Name, s.Value.Class, want[i])
+			}
+		}
+
+		negZero := specials[1].Value
+		if negZero.Float != 0 || !math.Signbit(negZero.Float) || negZero.Sign != 1 {
+			t.Errorf("%s: cero negativo %+v", f.Name, negZero)
+		}
+		if !math.IsInf(specials[7].Value.Float, 1) || !math.IsInf(specials[8].Value.Float, -1) {
+			t.Errorf("%s: infinitos %v y %v", f.Name, specials[7].Value.Float, specials[8].Value.Float)
+		}
+		if next := specials[3].Value.Next(); next.Bits != specials[4].Value.Bits {
+			t.Errorf("%s: después del mayor subnormal va %#x, se esperaba el menor normal", f.Name, next.Bits)
+		}
+	}
+
+	f64 := Specials(Float64)
+	if f64[2].Value.Float != math.SmallestNonzeroFloat64 || f64[6].Value.Float != math.MaxFloat64 {
+		t.Errorf("float64: menor subnormal %v y mayor finito %v", f64[2].Value.Float, f64[6].Value.Float)
+	}
+	f32 := Specials(Float32)
+	if f32[2].Value.Float != math.SmallestNonzeroFloat32 || f32[6].Value.Float != math.MaxFloat32 {
+		t.Errorf("float32: menor subnormal %v y mayor finito %v", f32[2].Value.Float, f32[6].Value.Float)
+	}
+	if f64[10].Value.Mantissa&0x3f != 0x2a {
+		t.Errorf("NaN con carga útil: mantisa %#x", f64[10].Value.Mantissa)
+	}
+}
@@ -0,0 +1,112 @@
+package ieee754
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// Write escribe el desglose de v.
+func (v *Value) Write(w io.Writer) error {
+	if v.Literal != "" {
+		fmt.Fprintf(w, "%s como %s\n", v.Literal, v.Format.Name)
+	} else {
+		fmt.Fprintf(w, "%s como %s\n", v.hex(), v.Format.Name)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "  Bits:\t%s\n", v.bitString())
+	fmt.Fprintf(tw, "  Hex:\t%s\n", v.hex())
+	fmt.Fprintf(tw, "  Clase:\t%s\n", v.Class)
+	fmt.Fprintf(tw, "  Signo:\t%d (%s)\n", v.Sign, map[uint64]string{0: "+", 1: "-"}[v.Sign])
+
+	switch v.Class {
+	case Normal, Subnormal:
+		if v.Class == Subnormal {
+			fmt.Fprintf(tw, "  Exponente:\t0, se usa 1 - %d = %d\n", v.Format.Bias, v.Unbiased())
+		} else {
+			fmt.Fprintf(tw, "  Exponente:\t%d - %d = %d\n", v.Exponent, v.Format.Bias, v.Unbiased())
+		}
+		implicit := "con el 1 implícito"
+		if v.Class == Subnormal {
+			implicit = "sin 1 implícito, por ser subnormal"
+		}
+		fmt.Fprintf(tw, "  Mantisa:\t%#x → %s (%s)\n", v.Mantissa, strconv.FormatFloat(v.Significand(), 'g', -1, 64), implicit)
+		fmt.Fprintf(tw, "  Fórmula:\t%s × %s × 2^%d\n", map[uint64]string{0: "+1", 1: "-1"}[v.Sign],
+			strconv.FormatFloat(v.Significand(), 'g', -1, 64), v.Unbiased())
+	case NaN:
+		fmt.Fprintf(tw, "  Exponente:\t%d (todos en 1)\n", v.Exponent)
+		kind := "silencioso"
+		if v.Mantissa>>(v.Format.MantBits-1) == 0 {
+			kind = "señalizador"
+		}
+		fmt.Fprintf(tw, "  Carga útil:\t%#x (%s)\n", v.Mantissa, kind)
+	default:
+		fmt.Fprintf(tw, "  Exponente:\t%d\n", v.Exponent)
+		fmt.Fprintf(tw, "  Mantisa:\t%#x\n", v.Mantissa)
+	}
+	if v.Class == Inf && v.Literal != "" && !strings.Contains(strings.ToLower(v.Literal), "inf") {
+		fmt.Fprintf(tw, "  Desborde:\tel literal no cabe en %s; el compilador lo rechazaría\n", v.Format.Name)
+	}
+
+	if v.Exact != nil {
+		fmt.Fprintf(tw, "  Guardado:\t%s\n", Decimal(v.Exact))
+		fmt.Fprintf(tw, "  Se imprime:\t%s\n", v.shortest())
+		fmt.Fprintf(tw, "  ULP:\t%s\n", ratString(v.ULP()))
+		fmt.Fprintf(tw, "  Siguiente:\t%s\n", v.Next().shortest())
+		fmt.Fprintf(tw, "  Anterior:\t%s\n", v.Prev().shortest())
+	}
+	if v.Error != nil {
+		if v.Error.Sign() == 0 {
+			fmt.Fprintf(tw, "  Error:\t0, el literal es exacto\n")
+		} else {
+			fmt.Fprintf(tw, "  Error:\t%s (%.3f ULP)\n", ratString(v.Error), v.ErrorULPs())
+			fmt.Fprintf(tw, "  Error exacto:\t%s\n", Decimal(v.Error))
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+func (v *Value) hex() string {
+	return fmt.Sprintf("0x%0*x", v.Format.Bits/4, v.Bits)
+}
+
+// bitString separa los bits en signo, exponente y mantisa.
+func (v *Value) bitString() string {
+	bits := fmt.Sprintf("%0*b", v.Format.Bits, v.Bits)
+	return bits[:1] + " " + bits[1:1+v.Format.ExpBits] + " " + bits[1+v.Format.ExpBits:]
+}
+
+// shortest es el texto más corto que vuelve a dar el mismo valor, el que
+// muestra fmt.Println.
+func (v *Value) shortest() string {
+	return strconv.FormatFloat(v.Float, 'g', -1, v.Format.Bits)
+}
+
+func ratString(r *big.Rat) string {
+	f, _ := r.Float64()
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// WriteSpecials escribe la tabla de valores especiales de f.
+func WriteSpecials(w io.Writer, f Format) error {
+	fmt.Fprintf(w, "Valores especiales de %s\n", f.Name)
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "  Valor\tBits\tHex\tClase\tComo número")
+	for _, s := range Specials(f) {
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\n", s.Name, s.Value.bitString(), s.Value.hex(),
+			s.Value.Class, s.Value.shortest())
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
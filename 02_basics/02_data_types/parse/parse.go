@@ -0,0 +1,131 @@
+// Package parse convierte texto a cualquiera de los tipos primitivos de la
+// lección con una sola función genérica, Parse[T], y errores que dicen qué
+// se intentó convertir, a qué tipo y por qué falló.
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Primitive son los tipos que acepta Parse, incluidos los tipos definidos
+// sobre ellos, ej. type Celsius float64.
+type Primitive interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~complex64 | ~complex128 |
+		~bool | ~string
+}
+
+// Motivos de error. Son los mismos valores de strconv, así que
+// errors.Is(err, strconv.ErrRange) también funciona.
+var (
+	ErrSyntax = strconv.ErrSyntax // El texto no tiene la forma de un T
+	ErrRange  = strconv.ErrRange  // Es un número válido pero no cabe en T
+)
+
+// Error describe una conversión fallida.
+type Error struct {
+	Input  string // Texto original, antes de normalizarlo
+	Type   string // Tipo destino, ej. int8
+	Reason error  // ErrSyntax o ErrRange
+}
+
+func (e *Error) Error() string {
+	reason := "sintaxis inválida"
+	if e.Reason == ErrRange {
+		reason = "fuera de rango"
+	}
+	return fmt.Sprintf("parse: %q como %s: %s", e.Input, e.Type, reason)
+}
+
+func (e *Error) Unwrap() error { return e.Reason }
+
+// Parse convierte s en un T.
+//
+// Se ignoran los espacios alrededor. Los enteros aceptan los prefijos 0x,
+// 0o, 0b y 0 (octal) y guiones bajos entre dígitos, como en el código Go:
+// "0xFF", "1_000_000". Los reales aceptan además coma decimal: "3,14" y
+// "1.234,5" se leen como 3.14 y 1234.5. En un número con punto y coma, el
+// que aparece último es el separador decimal.
+//
+// Si hay error devuelve el valor cero de T y un *Error.
+func Parse[T Primitive](s string) (T, error) {
+	var v T
+	rv := reflect.ValueOf(&v).Elem()
+	text := strings.TrimSpace(s)
+
+	var err error
+	switch kind := rv.Kind(); kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		if n, err = strconv.ParseInt(text, 0, rv.Type().Bits()); err == nil {
+			rv.SetInt(n)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var n uint64
+		if strings.HasPrefix(text, "-") {
+			// ParseUint diría "sintaxis inválida" para -1, pero es un
+			// problema de rango
+			if _, ierr := strconv.ParseInt(text, 0, 64); ierr == nil {
+				return v, &Error{Input: s, Type: typeName(rv), Reason: ErrRange}
+			}
+		}
+		if n, err = strconv.ParseUint(text, 0, rv.Type().Bits()); err == nil {
+			rv.SetUint(n)
+		}
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		if f, err = strconv.ParseFloat(decimalPoint(text), rv.Type().Bits()); err == nil {
+			rv.SetFloat(f)
+		}
+	case reflect.Complex64, reflect.Complex128:
+		var c complex128
+		if c, err = strconv.ParseComplex(text, rv.Type().Bits()); err == nil {
+			rv.SetComplex(c)
+		}
+	case reflect.Bool:
+		var b bool
+		if b, err = strconv.ParseBool(text); err == nil {
+			rv.SetBool(b)
+		}
+	case reflect.String:
+		rv.SetString(s)
+	default:
+		panic("parse: tipo no soportado " + kind.String())
+	}
+
+	if err != nil {
+		reason := ErrSyntax
+		if errors.Is(err, strconv.ErrRange) {
+			reason = ErrRange
+		}
+		return v, &Error{Input: s, Type: typeName(rv), Reason: reason}
+	}
+	return v, nil
+}
+
+// decimalPoint convierte la coma decimal en punto y quita el separador de
+// miles, ej. "1.234,5" → "1234.5". Sin coma devuelve s igual.
+func decimalPoint(s string) string {
+	comma := strings.LastIndexByte(s, ',')
+	if comma < 0 {
+		return s
+	}
+	dot := strings.LastIndexByte(s, '.')
+	if dot > comma {
+		// 1,234.5: la coma separa miles
+		return strings.ReplaceAll(s, ",", "")
+	}
+	if strings.Count(s, ",") > 1 {
+		return s // 1,234,567 es ambiguo; ParseFloat lo rechaza
+	}
+	return strings.ReplaceAll(s[:comma], ".", "") + "." + s[comma+1:]
+}
+
+func typeName(rv reflect.Value) string {
+	return rv.Type().String()
+}
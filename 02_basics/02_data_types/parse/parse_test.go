@@ -0,0 +1,202 @@
+package parse
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type celsius float64
+
+func TestParse(t *testing.T) {
+	check := func(t *testing.T, got, want any, err error) {
+		t.Helper()
+		if err != nil || got != want {
+			t.Errorf("= %v (%T), %v; se esperaba %v", got, got, err, want)
+		}
+	}
+
+	t.Run("int", func(t *testing.T) {
+		for in, want := range map[string]int{"42": 42, " -7 ": -7, "0xFF": 255, "0o17": 15, "017": 15, "0b101": 5, "1_000_000": 1000000} {
+			got, err := Parse[int](in)
+			check(t, got, want, err)
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		for in, want := range map[string]int8{"127": 127, "-128": -128} {
+			got, err := Parse[int8](in)
+			check(t, got, want, err)
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		for in, want := range map[string]uint8{"255": 255, "0": 0} {
+			got, err := Parse[uint8](in)
+			check(t, got, want, err)
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		for in, want := range map[string]float64{
+			"3.14": 3.14, "3,14": 3.14, "1.234,5": 1234.5, "1,234.5": 1234.5, "-0,5": -0.5, "1e3": 1000,
+		} {
+			got, err := Parse[float64](in)
+			check(t, got, want, err)
+		}
+	})
+	t.Run("celsius", func(t *testing.T) {
+		got, err := Parse[celsius]("36,6")
+		check(t, got, celsius(36.6), err)
+	})
+	t.Run("complex128", func(t *testing.T) {
+		got, err := Parse[complex128]("1+2i")
+		check(t, got, 1+2i, err)
+	})
+	t.Run("bool", func(t *testing.T) {
+		for in, want := range map[string]bool{"true": true, "F": false, "1": true} {
+			got, err := Parse[bool](in)
+			check(t, got, want, err)
+		}
+	})
+	t.Run("string", func(t *testing.T) {
+		// Los strings se devuelven tal cual, con espacios
+		got, err := Parse[string](" hola ")
+		check(t, got, " hola ", err)
+	})
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		input  string
+		parse  func(string) error
+		typ    string
+		reason error
+	}{
+		{"128", parseErr[int8], "int8", ErrRange},
+		{"-129", parseErr[int8], "int8", ErrRange},
+		{"256", parseErr[uint8], "uint8", ErrRange},
+		{"-1", parseErr[uint8], "uint8", ErrRange},
+		{"-1", parseErr[uint], "uint", ErrRange},
+		{"99999999999999999999", parseErr[int64], "int64", ErrRange},
+		{"1e400", parseErr[float64], "float64", ErrRange},
+		{"1e39", parseErr[float32], "float32", ErrRange},
+		{"abc", parseErr[int], "int", ErrSyntax},
+		{"3,14", parseErr[int], "int", ErrSyntax},
+		{"3.14", parseErr[int], "int", ErrSyntax},
+		{"", parseErr[int], "int", ErrSyntax},
+		{"1__0", parseErr[int], "int", ErrSyntax},
+		{"-x", parseErr[uint8], "uint8", ErrSyntax},
+		{"1,234,567", parseErr[float64], "float64", ErrSyntax},
+		{"3,14,", parseErr[float64], "float64", ErrSyntax},
+		{"tal vez", parseErr[bool], "bool", ErrSyntax},
+		{"1+", parseErr[complex64], "complex64", ErrSyntax},
+		{"36,6 °C", parseErr[celsius], "parse.celsius", ErrSyntax},
+	}
+	for _, tt := range tests {
+		err := tt.parse(tt.input)
+		var pe *Error
+		if !errors.As(err, &pe) {
+			t.Errorf("%q como %s: error %v, se esperaba un *Error", tt.input, tt.typ, err)
+			continue
+		}
+		if pe.Reason != tt.reason || pe.Type != tt.typ || pe.Input != tt.input {
+			t.Errorf("%q como %s: %+v, se esperaba %v", tt.input, tt.typ, pe, tt.reason)
+		}
+		// También se reconocen con los errores de strconv
+		other := ErrSyntax
+		if tt.reason == ErrSyntax {
+			other = ErrRange
+		}
+		if !errors.Is(err, tt.reason) || errors.Is(err, other) {
+			t.Errorf("%q como %s: errors.Is no distingue %v", tt.input, tt.typ, tt.reason)
+		}
+	}
+}
+
+func parseErr[T Primitive](s string) error {
+	v, err := Parse[T](s)
+	var zero T
+	if err != nil && v != zero {
+		return errors.New("con error no devolvió el valor cero")
+	}
+	return err
+}
+
+func FuzzParse(f *testing.F) {
+	for _, s := range []string{"0", "42", "-7", " 12 ", "0xFF", "0b1", "017", "1_000", "128", "-129", "-1",
+		"3.14", "3,14", "1.234,5", "1,234.5", "1e400", "NaN", "-Inf", "true", "1+2i", "", "abc", "9223372036854775808"} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		text := strings.TrimSpace(s)
+
+		// Los enteros coinciden con strconv y el valor leído vuelve a
+		// leerse igual después de formatearlo
+		n, err := Parse[int64](s)
+		want, werr := strconv.ParseInt(text, 0, 64)
+		sameResult(t, s, "int64", err, werr)
+		if err == nil {
+			if n != want {
+				t.Fatalf("%q como int64 = %d, strconv da %d", s, n, want)
+			}
+			if back, err := Parse[int64](strconv.FormatInt(n, 10)); err != nil || back != n {
+				t.Fatalf("%d no vuelve a leerse: %d, %v", n, back, err)
+			}
+		}
+
+		i8, err := Parse[int8](s)
+		if w8, werr := strconv.ParseInt(text, 0, 8); sameResult(t, s, "int8", err, werr) && err == nil && int64(i8) != w8 {
+			t.Fatalf("%q como int8 = %d, strconv da %d", s, i8, w8)
+		}
+
+		u8, err := Parse[uint8](s)
+		if wu8, werr := strconv.ParseUint(text, 0, 8); err == nil {
+			if werr != nil || uint64(u8) != wu8 {
+				t.Fatalf("%q como uint8 = %d, strconv da %d, %v", s, u8, wu8, werr)
+			}
+		} else if werr == nil {
+			t.Fatalf("%q como uint8: %v, pero strconv lo acepta", s, err)
+		}
+
+		// Sin coma, los reales se leen igual que con strconv
+		x, err := Parse[float64](s)
+		if !strings.Contains(s, ",") {
+			wx, werr := strconv.ParseFloat(text, 64)
+			sameResult(t, s, "float64", err, werr)
+			if err == nil && x != wx && !(math.IsNaN(x) && math.IsNaN(wx)) {
+				t.Fatalf("%q como float64 = %v, strconv da %v", s, x, wx)
+			}
+		}
+		if err == nil && !math.IsNaN(x) {
+			formatted := strconv.FormatFloat(x, 'g', -1, 64)
+			if back, err := Parse[float64](formatted); err != nil || back != x {
+				t.Fatalf("%v no vuelve a leerse: %v, %v", x, back, err)
+			}
+		}
+
+		b, err := Parse[bool](s)
+		wb, werr := strconv.ParseBool(text)
+		if sameResult(t, s, "bool", err, werr) && err == nil && b != wb {
+			t.Fatalf("%q como bool = %v, strconv da %v", s, b, wb)
+		}
+	})
+}
+
+// sameResult revisa que Parse y strconv fallen a la vez y por el mismo
+// motivo; devuelve true si no se detuvo la prueba.
+func sameResult(t *testing.T, s, typ string, err, werr error) bool {
+	t.Helper()
+	if (err == nil) != (werr == nil) {
+		t.Fatalf("%q como %s: Parse da %v, strconv da %v", s, typ, err, werr)
+	}
+	if err != nil && errors.Is(err, ErrRange) != errors.Is(werr, strconv.ErrRange) {
+		t.Fatalf("%q como %s: Parse da %v, strconv da %v", s, typ, err, werr)
+	}
+	if err != nil {
+		var pe *Error
+		if !errors.As(err, &pe) || pe.Input != s {
+			t.Fatalf("%q como %s: error %v sin el texto original", s, typ, err)
+		}
+	}
+	return true
+}
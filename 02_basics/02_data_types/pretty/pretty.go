@@ -0,0 +1,161 @@
+// Package pretty formatea cada tipo de la lección de tipos de datos a su
+// manera, con un manejador por tipo registrado en un dispatch.Registry en
+// lugar de un switch de tipos.
+package pretty
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/FepDev25/gobootcamp/02_basics/02_data_types/dispatch"
+	"github.com/FepDev25/gobootcamp/02_basics/02_data_types/serial"
+)
+
+var registry = dispatch.New[string]()
+
+func init() {
+	// Enteros: el valor y sus bits, en binario si el tipo es angosto
+	dispatch.Register(registry, func(v int8) string { return integer("int8", int64(v), 8) })
+	dispatch.Register(registry, func(v int16) string { return integer("int16", int64(v), 16) })
+	dispatch.Register(registry, func(v int32) string { return integer("int32", int64(v), 32) })
+	dispatch.Register(registry, func(v int64) string { return integer("int64", v, 64) })
+	dispatch.Register(registry, func(v int) string { return integer("int", int64(v), strconv.IntSize) })
+	dispatch.Register(registry, func(v uint8) string { return unsigned("uint8", uint64(v), 8) })
+	dispatch.Register(registry, func(v uint16) string { return unsigned("uint16", uint64(v), 16) })
+	dispatch.Register(registry, func(v uint32) string { return unsigned("uint32", uint64(v), 32) })
+	dispatch.Register(registry, func(v uint64) string { return unsigned("uint64", v, 64) })
+	dispatch.Register(registry, func(v uint) string { return unsigned("uint", uint64(v), strconv.IntSize) })
+	dispatch.Register(registry, func(v uintptr) string { return fmt.Sprintf("uintptr %#x", v) })
+
+	// Reales y complejos
+	dispatch.Register(registry, func(v float32) string {
+		return fmt.Sprintf("float32 %s (≈%.3e)", strconv.FormatFloat(float64(v), 'g', -1, 32), v)
+	})
+	dispatch.Register(registry, func(v float64) string {
+		return fmt.Sprintf("float64 %s (≈%.3e)", strconv.FormatFloat(v, 'g', -1, 64), v)
+	})
+	dispatch.Register(registry, func(v complex64) string { return complexNumber(complex128(v), 32) })
+	dispatch.Register(registry, func(v complex128) string { return complexNumber(v, 64) })
+
+	dispatch.Register(registry, func(v bool) string {
+		if v {
+			return "bool ✔ verdadero"
+		}
+		return "bool ✘ falso"
+	})
+	dispatch.Register(registry, func(v string) string {
+		return fmt.Sprintf("string %q (%d bytes, %d runas)", v, len(v), len([]rune(v)))
+	})
+
+	// Compuestos de la lección
+	dispatch.Register(registry, func(v []int) string { return list("[]int", len(v), cap(v), v) })
+	dispatch.Register(registry, func(v [3]string) string { return list("[3]string", len(v), -1, v[:]) })
+	dispatch.Register(registry, func(v [2][2]int) string {
+		return fmt.Sprintf("matriz 2×2\n  │%4d %4d │\n  │%4d %4d │", v[0][0], v[0][1], v[1][0], v[1][1])
+	})
+	dispatch.Register(registry, func(v map[string]int) string { return table(v) })
+	dispatch.Register(registry, func(v map[string]string) string { return table(v) })
+	dispatch.Register(registry, func(v *int) string {
+		if v == nil {
+			return "*int nil"
+		}
+		return fmt.Sprintf("*int → %d", *v)
+	})
+	dispatch.Register(registry, func(v func(int, int) int) string {
+		if v == nil {
+			return "func(int, int) int nil"
+		}
+		return fmt.Sprintf("func(int, int) int, ej. f(3, 4) = %d", v(3, 4))
+	})
+	dispatch.Register(registry, func(v serial.Persona) string {
+		return fmt.Sprintf("Persona %s, %d años <%s>", v.Nombre, v.Edad, v.Email)
+	})
+	dispatch.Register(registry, func(v serial.Usuario) string {
+		return fmt.Sprintf("Usuario %s, %d años", v.Nombre, v.Edad)
+	})
+
+	// Interfaces: para los tipos que no tienen un manejador propio
+	dispatch.Register(registry, func(v error) string { return "error: " + v.Error() })
+	dispatch.Register(registry, func(v fmt.Stringer) string { return fmt.Sprintf("%T %s", v, v.String()) })
+
+	registry.SetDefault(func(v any) string {
+		if v == nil {
+			return "nil"
+		}
+		return fmt.Sprintf("%T %v", v, v)
+	})
+}
+
+// Format devuelve v formateado según su tipo.
+func Format(v any) string {
+	s, _ := registry.Dispatch(v)
+	return s
+}
+
+// Register agrega o reemplaza el formato de T, ej. para tipos propios.
+func Register[T any](format func(T) string) {
+	dispatch.Register(registry, format)
+}
+
+func integer(name string, v int64, bits int) string {
+	// Complemento a dos con el ancho del tipo
+	return fmt.Sprintf("%s %d (%s)", name, v, bitString(uint64(v)&(math.MaxUint64>>(64-bits)), bits))
+}
+
+func unsigned(name string, v uint64, bits int) string {
+	return fmt.Sprintf("%s %d (%s)", name, v, bitString(v, bits))
+}
+
+func bitString(v uint64, bits int) string {
+	if bits <= 16 {
+		return fmt.Sprintf("0b%0*b", bits, v)
+	}
+	return fmt.Sprintf("0x%0*x", bits/4, v)
+}
+
+func complexNumber(v complex128, bits int) string {
+	f := func(x float64) string { return strconv.FormatFloat(x, 'g', 4, bits) }
+	sign := "+"
+	if imag(v) < 0 {
+		sign = "-"
+	}
+	return fmt.Sprintf("complex%d %s %s %si = %s∠%s rad", bits*2,
+		f(real(v)), sign, f(math.Abs(imag(v))), f(cmplx.Abs(v)), f(cmplx.Phase(v)))
+}
+
+// list formatea cada elemento con Format. cap < 0 indica un arreglo.
+func list[T any](name string, length, capacity int, items []T) string {
+	var b strings.Builder
+	if capacity < 0 {
+		fmt.Fprintf(&b, "%s con %d elementos", name, length)
+	} else {
+		fmt.Fprintf(&b, "%s len=%d cap=%d", name, length, capacity)
+	}
+	for i, item := range items {
+		fmt.Fprintf(&b, "\n  [%d] %s", i, Format(item))
+	}
+	return b.String()
+}
+
+// table escribe un mapa con las claves ordenadas y alineadas.
+func table[V any](m map[string]V) string {
+	keys := make([]string, 0, len(m))
+	width := 0
+	for k := range m {
+		keys = append(keys, k)
+		width = max(width, len(k))
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s con %d claves", reflect.TypeOf(m), len(m))
+	for _, k := range keys {
+		fmt.Fprintf(&b, "\n  %-*s → %v", width, k, m[k])
+	}
+	return b.String()
+}
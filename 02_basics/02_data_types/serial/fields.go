@@ -0,0 +1,209 @@
+package serial
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// field es un campo con su nombre en la etiqueta del formato.
+type field struct {
+	name  string
+	index int
+}
+
+// fields devuelve los campos de T según la etiqueta tag (csv o kv). Los
+// campos sin etiqueta usan su nombre en minúsculas y "-" los omite.
+func fields[T Record](tag string) []field {
+	t := reflect.TypeFor[T]()
+	var out []field
+	for i := range t.NumField() {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get(tag), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = strings.ToLower(f.Name)
+		}
+		out = append(out, field{name, i})
+	}
+	return out
+}
+
+// format y set convierten un campo a texto y de vuelta. Los structs del
+// paquete solo tienen string e int.
+func format(v reflect.Value) string {
+	if v.Kind() == reflect.Int {
+		return strconv.FormatInt(v.Int(), 10)
+	}
+	return v.String()
+}
+
+func set(v reflect.Value, text string) error {
+	if v.Kind() == reflect.Int {
+		n, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+		return nil
+	}
+	v.SetString(text)
+	return nil
+}
+
+// encodeCSV escribe una cabecera con los nombres de las etiquetas csv.
+func encodeCSV[T Record](w io.Writer, items []T) error {
+	fs := fields[T]("csv")
+	cw := csv.NewWriter(w)
+	header := make([]string, len(fs))
+	for i, f := range fs {
+		header[i] = f.name
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, item := range items {
+		v := reflect.ValueOf(item)
+		row := make([]string, len(fs))
+		for i, f := range fs {
+			row[i] = format(v.Field(f.index))
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// decodeCSV acepta las columnas en cualquier orden; las que no conoce se
+// ignoran.
+func decodeCSV[T Record](r io.Reader) ([]T, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err == io.EOF {
+		return []T{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]int)
+	for _, f := range fields[T]("csv") {
+		byName[f.name] = f.index
+	}
+	columns := make([]int, len(header))
+	for i, name := range header {
+		index, ok := byName[strings.TrimSpace(name)]
+		if !ok {
+			index = -1
+		}
+		columns[i] = index
+	}
+
+	items := []T{}
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			return items, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var item T
+		v := reflect.ValueOf(&item).Elem()
+		for i, text := range row {
+			if columns[i] < 0 {
+				continue
+			}
+			if err := set(v.Field(columns[i]), text); err != nil {
+				line, _ := cr.FieldPos(i)
+				return nil, fmt.Errorf("línea %d, columna %s: %w", line, header[i], err)
+			}
+		}
+		items = append(items, item)
+	}
+}
+
+// encodeKV escribe cada registro como una tabla al estilo TOML:
+//
+//	[[persona]]
+//	nombre = "Felipe"
+//	edad = 20
+func encodeKV[T Record](w io.Writer, items []T) error {
+	name := elementName[T]()
+	fs := fields[T]("kv")
+	bw := bufio.NewWriter(w)
+	for i, item := range items {
+		if i > 0 {
+			bw.WriteString("\n")
+		}
+		fmt.Fprintf(bw, "[[%s]]\n", name)
+		v := reflect.ValueOf(item)
+		for _, f := range fs {
+			value := v.Field(f.index)
+			text := format(value)
+			if value.Kind() == reflect.String {
+				text = strconv.Quote(text)
+			}
+			fmt.Fprintf(bw, "%s = %s\n", f.name, text)
+		}
+	}
+	return bw.Flush()
+}
+
+// decodeKV lee lo que escribe encodeKV. Acepta comentarios con # y líneas
+// en blanco.
+func decodeKV[T Record](r io.Reader) ([]T, error) {
+	name := elementName[T]()
+	byName := make(map[string]int)
+	for _, f := range fields[T]("kv") {
+		byName[f.name] = f.index
+	}
+
+	items := []T{}
+	var current reflect.Value
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		switch {
+		case text == "" || strings.HasPrefix(text, "#"):
+			continue
+		case text == "[["+name+"]]":
+			items = append(items, *new(T))
+			current = reflect.ValueOf(&items[len(items)-1]).Elem()
+			continue
+		case strings.HasPrefix(text, "["):
+			return nil, fmt.Errorf("línea %d: se esperaba [[%s]]", line, name)
+		case !current.IsValid():
+			return nil, fmt.Errorf("línea %d: clave fuera de una tabla [[%s]]", line, name)
+		}
+
+		key, value, ok := strings.Cut(text, "=")
+		if !ok {
+			return nil, fmt.Errorf("línea %d: falta '='", line)
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		index, ok := byName[key]
+		if !ok {
+			return nil, fmt.Errorf("línea %d: clave desconocida %q", line, key)
+		}
+		if strings.HasPrefix(value, `"`) {
+			unquoted, err := strconv.Unquote(value)
+			if err != nil {
+				return nil, fmt.Errorf("línea %d: texto mal cerrado: %s", line, value)
+			}
+			value = unquoted
+		}
+		if err := set(current.Field(index), value); err != nil {
+			return nil, fmt.Errorf("línea %d: %s: %w", line, key, err)
+		}
+	}
+	return items, scanner.Err()
+}
@@ -0,0 +1,154 @@
+// Package serial guarda y lee listas de Usuario y Persona en varios
+// formatos: JSON, XML, CSV, gob y un formato key=value parecido a TOML.
+// Todos hacen el viaje de ida y vuelta sin perder datos.
+package serial
+
+import (
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// Format es un formato de serialización.
+type Format string
+
+const (
+	JSON Format = "json"
+	XML  Format = "xml"
+	CSV  Format = "csv"
+	Gob  Format = "gob"
+	KV   Format = "kv"
+)
+
+// Formats son todos los formatos disponibles.
+var Formats = []Format{JSON, XML, CSV, Gob, KV}
+
+// ErrUnknownFormat se devuelve para formatos que no están en Formats.
+var ErrUnknownFormat = errors.New("serial: formato desconocido")
+
+// Record son los tipos que se pueden serializar.
+type Record interface {
+	Usuario | Persona
+}
+
+// FormatFor deduce el formato por la extensión del archivo, ej. datos.csv.
+func FormatFor(path string) (Format, error) {
+	ext := strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	if ext == "toml" {
+		return KV, nil
+	}
+	for _, f := range Formats {
+		if string(f) == ext {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownFormat, filepath.Ext(path))
+}
+
+// Encode escribe items en el formato f.
+func Encode[T Record](w io.Writer, f Format, items []T) error {
+	switch f {
+	case JSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(items)
+	case XML:
+		return encodeXML(w, items)
+	case CSV:
+		return encodeCSV(w, items)
+	case Gob:
+		return gob.NewEncoder(w).Encode(items)
+	case KV:
+		return encodeKV(w, items)
+	}
+	return fmt.Errorf("%w: %q", ErrUnknownFormat, f)
+}
+
+// Decode lee una lista en el formato f.
+func Decode[T Record](r io.Reader, f Format) ([]T, error) {
+	var items []T
+	var err error
+	switch f {
+	case JSON:
+		err = json.NewDecoder(r).Decode(&items)
+	case XML:
+		items, err = decodeXML[T](r)
+	case CSV:
+		items, err = decodeCSV[T](r)
+	case Gob:
+		err = gob.NewDecoder(r).Decode(&items)
+	case KV:
+		items, err = decodeKV[T](r)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, f)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", f, err)
+	}
+	return items, nil
+}
+
+// elementName es el nombre de cada registro en XML y KV, ej. persona.
+func elementName[T Record]() string {
+	return strings.ToLower(reflect.TypeFor[T]().Name())
+}
+
+// encodeXML escribe <personas><persona>...</persona></personas>.
+func encodeXML[T Record](w io.Writer, items []T) error {
+	name := elementName[T]()
+	root := xml.StartElement{Name: xml.Name{Local: name + "s"}}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.EncodeToken(root); err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := enc.EncodeElement(item, xml.StartElement{Name: xml.Name{Local: name}}); err != nil {
+			return err
+		}
+	}
+	if err := enc.EncodeToken(root.End()); err != nil {
+		return err
+	}
+	if err := enc.Flush(); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func decodeXML[T Record](r io.Reader) ([]T, error) {
+	name := elementName[T]()
+	dec := xml.NewDecoder(r)
+	items := []T{}
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return items, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if depth == 1 && t.Name.Local == name {
+				var item T
+				if err := dec.DecodeElement(&item, &t); err != nil {
+					return nil, err
+				}
+				items = append(items, item)
+				continue
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+}
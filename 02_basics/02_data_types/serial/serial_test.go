@@ -0,0 +1,109 @@
+package serial
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var personas = []Persona{
+	{Nombre: "Felipe", Edad: 20, Email: "felipe@example.com"},
+	{Nombre: "Peralta, Emilia", Edad: 0},                         // Coma y campos vacíos
+	{Nombre: `Karen "K" Ñandú`, Edad: 31, Email: "k@ejemplo.ec"}, // Comillas y UTF-8
+	{Nombre: "a = b # no es comentario", Edad: -1},
+}
+
+var usuarios = []Usuario{
+	{Nombre: "Felipe", Edad: 20},
+	{Nombre: "<Emilia & Karen>", Edad: 0},
+}
+
+// convert pasa items de from a to y de vuelta, como cmd/convert.
+func convert[T Record](t *testing.T, items []T, from, to Format) []T {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := Encode(&buf, from, items); err != nil {
+		t.Fatalf("Encode %s: %v", from, err)
+	}
+	decoded, err := Decode[T](&buf, from)
+	if err != nil {
+		t.Fatalf("Decode %s: %v", from, err)
+	}
+	buf.Reset()
+	if err := Encode(&buf, to, decoded); err != nil {
+		t.Fatalf("Encode %s: %v", to, err)
+	}
+	out, err := Decode[T](&buf, to)
+	if err != nil {
+		t.Fatalf("Decode %s: %v\n%s", to, err, buf.String())
+	}
+	return out
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, from := range Formats {
+		for _, to := range Formats {
+			t.Run(string(from)+"→"+string(to), func(t *testing.T) {
+				if got := convert(t, personas, from, to); !reflect.DeepEqual(got, personas) {
+					t.Errorf("personas:\n%+v\nse esperaba:\n%+v", got, personas)
+				}
+				if got := convert(t, usuarios, from, to); !reflect.DeepEqual(got, usuarios) {
+					t.Errorf("usuarios:\n%+v\nse esperaba:\n%+v", got, usuarios)
+				}
+			})
+		}
+	}
+}
+
+func TestRoundTripEmpty(t *testing.T) {
+	for _, f := range Formats {
+		var buf bytes.Buffer
+		if err := Encode(&buf, f, []Persona{}); err != nil {
+			t.Fatalf("%s: %v", f, err)
+		}
+		got, err := Decode[Persona](&buf, f)
+		if err != nil || len(got) != 0 {
+			t.Errorf("%s: lista vacía = %v, %v", f, got, err)
+		}
+	}
+}
+
+func TestInvalidEmail(t *testing.T) {
+	bad := []Persona{{Nombre: "Felipe", Email: "no-es-un-email"}}
+	var buf bytes.Buffer
+	if err := Encode(&buf, JSON, bad); !errors.Is(err, ErrInvalidEmail) {
+		t.Errorf("Encode JSON = %v, se esperaba ErrInvalidEmail", err)
+	}
+
+	for _, email := range []string{"no-es-un-email", "Felipe <f@x.com>", "@x.com"} {
+		data := `[{"nombre":"Felipe","email":"` + strings.ReplaceAll(email, `"`, `\"`) + `"}]`
+		if _, err := Decode[Persona](strings.NewReader(data), JSON); !errors.Is(err, ErrInvalidEmail) {
+			t.Errorf("Decode JSON con %q = %v, se esperaba ErrInvalidEmail", email, err)
+		}
+	}
+}
+
+func TestUnknownFormat(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Encode(&buf, "yaml", personas); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("Encode = %v", err)
+	}
+	if _, err := Decode[Persona](&buf, "yaml"); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("Decode = %v", err)
+	}
+	if _, err := FormatFor("datos.yaml"); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("FormatFor = %v", err)
+	}
+}
+
+func TestFormatFor(t *testing.T) {
+	for path, want := range map[string]Format{
+		"datos.json": JSON, "DATOS.XML": XML, "a/b.csv": CSV, "x.gob": Gob, "x.kv": KV, "config.toml": KV,
+	} {
+		if got, err := FormatFor(path); err != nil || got != want {
+			t.Errorf("FormatFor(%q) = %q, %v; se esperaba %q", path, got, err, want)
+		}
+	}
+}
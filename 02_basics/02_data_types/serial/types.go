@@ -0,0 +1,63 @@
+package serial
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/mail"
+)
+
+// ErrInvalidEmail se devuelve al codificar o decodificar en JSON una
+// Persona con un email mal formado.
+var ErrInvalidEmail = errors.New("serial: email inválido")
+
+// Usuario es el struct de especiales() con etiquetas para cada formato.
+type Usuario struct {
+	Nombre string `json:"nombre" xml:"nombre" csv:"nombre" kv:"nombre"`
+	Edad   int    `json:"edad,omitempty" xml:"edad,omitempty" csv:"edad" kv:"edad"`
+}
+
+// Persona es el struct de primitivos() con etiquetas para cada formato.
+type Persona struct {
+	Nombre string `json:"nombre" xml:"nombre" csv:"nombre" kv:"nombre"`
+	Edad   int    `json:"edad,omitempty" xml:"edad,omitempty" csv:"edad" kv:"edad"`
+	Email  string `json:"email,omitempty" xml:"email,omitempty" csv:"email" kv:"email"`
+}
+
+// persona tiene los mismos campos sin los métodos, para que MarshalJSON
+// no se llame a sí mismo.
+type persona Persona
+
+// MarshalJSON valida el email antes de codificar.
+func (p Persona) MarshalJSON() ([]byte, error) {
+	if err := validEmail(p.Email); err != nil {
+		return nil, err
+	}
+	return json.Marshal(persona(p))
+}
+
+// UnmarshalJSON rechaza los emails inválidos al decodificar.
+func (p *Persona) UnmarshalJSON(data []byte) error {
+	var v persona
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if err := validEmail(v.Email); err != nil {
+		return err
+	}
+	*p = Persona(v)
+	return nil
+}
+
+// validEmail acepta el email vacío, ya que el campo es opcional.
+func validEmail(email string) error {
+	if email == "" {
+		return nil
+	}
+	// ParseAddress también acepta "Felipe <f@x.com>"; solo se quiere la dirección
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return fmt.Errorf("%w: %q", ErrInvalidEmail, email)
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package sample
+
+// Registro tiene relleno entre campos y al final.
+type Registro struct {
+	Activo bool
+	Total  int64
+	Letra  byte
+	Codigo int32
+	Marca  bool
+}
+
+type Celsius float64
+
+func local() {
+	type Par struct {
+		A int8
+		B int16
+	}
+	_ = Par{}
+}
@@ -0,0 +1,254 @@
+// Package typeinfo describe cómo se guarda un tipo en memoria: tamaño,
+// alineación, desplazamiento de cada campo, relleno, rango de valores y
+// valor cero. Trabaja con go/types, así que sirve para tipos del código
+// fuente sin necesidad de ejecutarlo.
+package typeinfo
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"go/types"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/FepDev25/gobootcamp/internal/loader"
+)
+
+// ErrUnknownType se devuelve cuando no se encuentra el tipo pedido.
+var ErrUnknownType = errors.New("typeinfo: tipo desconocido")
+
+// Info es la descripción de un tipo.
+type Info struct {
+	Expr       string // Lo que se pidió, ej. Persona o 3.14
+	Type       types.Type
+	Literal    string // Valor de la constante si se pidió un literal
+	Size       int64
+	Align      int64
+	Comparable bool
+	Zero       string
+	Min, Max   string // Vacíos si el tipo no es numérico
+
+	Fields          []Field // Solo para structs
+	TrailingPadding int64
+	Suggested       []Field // Orden con menos relleno; nil si el actual ya es óptimo
+	SuggestedSize   int64
+}
+
+// Field es un campo de un struct.
+type Field struct {
+	Name    string
+	Type    types.Type
+	Offset  int64
+	Size    int64
+	Align   int64
+	Padding int64 // Bytes de relleno antes del campo siguiente
+}
+
+// Padding devuelve el relleno total del struct.
+func (info *Info) Padding() int64 {
+	total := info.TrailingPadding
+	for _, f := range info.Fields {
+		total += f.Padding
+	}
+	return total
+}
+
+// Resolver busca tipos por nombre o expresión.
+type Resolver struct {
+	Pkg   *loader.Package // nil solo resuelve tipos predeclarados y literales
+	Sizes types.Sizes
+}
+
+// Lookup evalúa expr como tipo o como literal. Además de los tipos del
+// paquete acepta los declarados dentro de funciones, como Persona en
+// data_types.go, y expresiones que los usan, ej. []Persona.
+func (r *Resolver) Lookup(expr string) (*Info, error) {
+	var fset *token.FileSet
+	var pkg *types.Package
+	if r.Pkg != nil {
+		fset, pkg = r.Pkg.Fset, r.Pkg.Types
+	} else {
+		fset = token.NewFileSet()
+	}
+
+	tv, err := types.Eval(fset, pkg, token.NoPos, expr)
+	if err != nil && r.Pkg != nil {
+		// Prueba en el ámbito de cada tipo local, justo después de declararlo
+		for _, obj := range r.localTypes() {
+			if local, lerr := types.Eval(fset, pkg, obj.Pos(), expr); lerr == nil {
+				tv, err = local, nil
+				break
+			}
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownType, expr)
+	}
+
+	info := &Info{Expr: expr}
+	switch {
+	case tv.IsType():
+		info.Type = tv.Type
+	case tv.Value != nil:
+		info.Type = types.Default(tv.Type)
+		info.Literal = tv.Value.String()
+	default:
+		info.Type = tv.Type
+	}
+	r.describe(info)
+	return info, nil
+}
+
+// localTypes devuelve los tipos declarados dentro de funciones.
+func (r *Resolver) localTypes() []*types.TypeName {
+	var local []*types.TypeName
+	for _, obj := range r.Pkg.Info.Defs {
+		if tn, ok := obj.(*types.TypeName); ok && tn.Parent() != nil && tn.Parent() != r.Pkg.Types.Scope() {
+			local = append(local, tn)
+		}
+	}
+	sort.Slice(local, func(i, j int) bool { return local[i].Pos() < local[j].Pos() })
+	return local
+}
+
+func (r *Resolver) describe(info *Info) {
+	t := info.Type
+	info.Size = r.Sizes.Sizeof(t)
+	info.Align = r.Sizes.Alignof(t)
+	info.Comparable = types.Comparable(t)
+	info.Zero = r.zero(t, 1)
+	info.Min, info.Max = limits(t, info.Size)
+
+	st, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return
+	}
+	info.Fields, info.TrailingPadding = r.layout(fieldsOf(st))
+
+	// Ordenar por alineación de mayor a menor deja el mínimo relleno
+	sorted := fieldsOf(st)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return r.Sizes.Alignof(sorted[i].Type()) > r.Sizes.Alignof(sorted[j].Type())
+	})
+	size := r.Sizes.Sizeof(types.NewStruct(sorted, nil))
+	if size < info.Size {
+		info.Suggested, _ = r.layout(sorted)
+		info.SuggestedSize = size
+	}
+}
+
+func fieldsOf(st *types.Struct) []*types.Var {
+	fields := make([]*types.Var, st.NumFields())
+	for i := range fields {
+		fields[i] = st.Field(i)
+	}
+	return fields
+}
+
+func (r *Resolver) layout(vars []*types.Var) ([]Field, int64) {
+	offsets := r.Sizes.Offsetsof(vars)
+	total := r.Sizes.Sizeof(types.NewStruct(vars, nil))
+	fields := make([]Field, len(vars))
+	for i, v := range vars {
+		fields[i] = Field{
+			Name:   v.Name(),
+			Type:   v.Type(),
+			Offset: offsets[i],
+			Size:   r.Sizes.Sizeof(v.Type()),
+			Align:  r.Sizes.Alignof(v.Type()),
+		}
+	}
+	var trailing int64
+	for i := range fields {
+		end := fields[i].Offset + fields[i].Size
+		if i+1 < len(fields) {
+			fields[i].Padding = fields[i+1].Offset - end
+		} else {
+			trailing = total - end
+		}
+	}
+	return fields, trailing
+}
+
+// limits devuelve el mínimo y el máximo de los tipos numéricos.
+func limits(t types.Type, size int64) (min, max string) {
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok {
+		return "", ""
+	}
+	bits := uint(size * 8)
+	info := basic.Info()
+	switch {
+	case info&types.IsInteger != 0 && info&types.IsUnsigned != 0:
+		hi := new(big.Int).Lsh(big.NewInt(1), bits)
+		return "0", hi.Sub(hi, big.NewInt(1)).String()
+	case info&types.IsInteger != 0:
+		hi := new(big.Int).Lsh(big.NewInt(1), bits-1)
+		lo := new(big.Int).Neg(hi)
+		return lo.String(), hi.Sub(hi, big.NewInt(1)).String()
+	case info&types.IsComplex != 0:
+		min, max = floatLimits(bits / 2)
+		return min + " (por componente)", max + " (por componente)"
+	case info&types.IsFloat != 0:
+		return floatLimits(bits)
+	}
+	return "", ""
+}
+
+func floatLimits(bits uint) (min, max string) {
+	if bits == 32 {
+		return fmt.Sprint(float32(-math.MaxFloat32)), fmt.Sprint(float32(math.MaxFloat32))
+	}
+	return fmt.Sprint(-math.MaxFloat64), fmt.Sprint(math.MaxFloat64)
+}
+
+// zero escribe el valor cero de t como un literal de Go. Los structs se
+// expanden hasta depth niveles.
+func (r *Resolver) zero(t types.Type, depth int) string {
+	name := r.TypeString(t)
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		var v string
+		switch {
+		case u.Info()&types.IsBoolean != 0:
+			v = "false"
+		case u.Info()&types.IsString != 0:
+			v = `""`
+		case u.Kind() == types.UnsafePointer:
+			v = "nil"
+		default:
+			v = "0"
+		}
+		if _, named := t.(*types.Named); named {
+			return name + "(" + v + ")"
+		}
+		return v
+	case *types.Struct:
+		if depth <= 0 || u.NumFields() == 0 {
+			return name + "{}"
+		}
+		parts := make([]string, u.NumFields())
+		for i := range parts {
+			f := u.Field(i)
+			parts[i] = f.Name() + ": " + r.zero(f.Type(), depth-1)
+		}
+		return name + "{" + strings.Join(parts, ", ") + "}"
+	case *types.Array:
+		return name + "{}"
+	}
+	return "nil"
+}
+
+// TypeString devuelve t como se escribiría en el código: sin calificar
+// los tipos del paquete analizado y con el nombre corto de los demás.
+func (r *Resolver) TypeString(t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string {
+		if r.Pkg != nil && p == r.Pkg.Types {
+			return ""
+		}
+		return p.Name()
+	})
+}
@@ -0,0 +1,156 @@
+package typeinfo_test
+
+import (
+	"errors"
+	"go/types"
+	"path/filepath"
+	"testing"
+
+	"github.com/FepDev25/gobootcamp/02_basics/02_data_types/typeinfo"
+	"github.com/FepDev25/gobootcamp/internal/loader"
+)
+
+func resolver(t *testing.T, arch string) *typeinfo.Resolver {
+	t.Helper()
+	pkg, err := loader.LoadDir(filepath.Join("testdata", "sample"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pkg.TypeErrors) > 0 {
+		t.Fatal(pkg.TypeErrors[0])
+	}
+	return &typeinfo.Resolver{Pkg: pkg, Sizes: types.SizesFor("gc", arch)}
+}
+
+func TestStructLayout(t *testing.T) {
+	tests := []struct {
+		arch          string
+		size, align   int64
+		offsets       []int64
+		padding       []int64
+		trailing      int64
+		suggestedSize int64
+	}{
+		// int64 se alinea a 8: 7 bytes de relleno tras Activo y al final
+		{"amd64", 32, 8, []int64{0, 8, 16, 20, 24}, []int64{7, 0, 3, 0, 0}, 7, 16},
+		// En 386 int64 se alinea a 4
+		{"386", 24, 4, []int64{0, 4, 12, 16, 20}, []int64{3, 0, 3, 0, 0}, 3, 16},
+	}
+	for _, tt := range tests {
+		info, err := resolver(t, tt.arch).Lookup("Registro")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Size != tt.size || info.Align != tt.align {
+			t.Errorf("%s: tamaño %d y alineación %d, se esperaba %d y %d", tt.arch, info.Size, info.Align, tt.size, tt.align)
+		}
+		if len(info.Fields) != len(tt.offsets) {
+			t.Fatalf("%s: %d campos, se esperaban %d", tt.arch, len(info.Fields), len(tt.offsets))
+		}
+		var padding int64
+		for i, f := range info.Fields {
+			if f.Offset != tt.offsets[i] || f.Padding != tt.padding[i] {
+				t.Errorf("%s: %s en %d con %d de relleno, se esperaba %d y %d", tt.arch, f.Name, f.Offset, f.Padding, tt.offsets[i], tt.padding[i])
+			}
+			padding += tt.padding[i]
+		}
+		if info.TrailingPadding != tt.trailing {
+			t.Errorf("%s: relleno final %d, se esperaba %d", tt.arch, info.TrailingPadding, tt.trailing)
+		}
+		if got := info.Padding(); got != padding+tt.trailing {
+			t.Errorf("%s: Padding() = %d, se esperaba %d", tt.arch, got, padding+tt.trailing)
+		}
+		if info.SuggestedSize != tt.suggestedSize || len(info.Suggested) != len(info.Fields) {
+			t.Errorf("%s: orden sugerido de %d bytes, se esperaba %d", tt.arch, info.SuggestedSize, tt.suggestedSize)
+		} else if first := info.Suggested[0].Name; first != "Total" {
+			t.Errorf("%s: el orden sugerido empieza por %s, se esperaba Total", tt.arch, first)
+		}
+	}
+}
+
+func TestLocalType(t *testing.T) {
+	info, err := resolver(t, "amd64").Lookup("Par")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size != 4 || info.Fields[1].Offset != 2 || info.Fields[0].Padding != 1 {
+		t.Errorf("Par: tamaño %d y campos %+v", info.Size, info.Fields)
+	}
+	// Ya está en el orden óptimo
+	if info.Suggested != nil {
+		t.Errorf("Par: orden sugerido %+v, se esperaba nil", info.Suggested)
+	}
+	if info.Zero != "Par{A: 0, B: 0}" {
+		t.Errorf("Par: valor cero %q", info.Zero)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	tests := []struct {
+		expr, arch string
+		size       int64
+		min, max   string
+	}{
+		{"int8", "amd64", 1, "-128", "127"},
+		{"uint8", "amd64", 1, "0", "255"},
+		{"byte", "amd64", 1, "0", "255"},
+		{"int16", "amd64", 2, "-32768", "32767"},
+		{"uint16", "amd64", 2, "0", "65535"},
+		{"int32", "amd64", 4, "-2147483648", "2147483647"},
+		{"rune", "amd64", 4, "-2147483648", "2147483647"},
+		{"uint32", "amd64", 4, "0", "4294967295"},
+		{"int64", "amd64", 8, "-9223372036854775808", "9223372036854775807"},
+		{"uint64", "amd64", 8, "0", "18446744073709551615"},
+		{"int", "amd64", 8, "-9223372036854775808", "9223372036854775807"},
+		{"int", "386", 4, "-2147483648", "2147483647"},
+		{"uint", "386", 4, "0", "4294967295"},
+		{"uintptr", "amd64", 8, "0", "18446744073709551615"},
+		{"float32", "amd64", 4, "-3.4028235e+38", "3.4028235e+38"},
+		{"float64", "amd64", 8, "-1.7976931348623157e+308", "1.7976931348623157e+308"},
+		{"complex64", "amd64", 8, "-3.4028235e+38 (por componente)", "3.4028235e+38 (por componente)"},
+		{"complex128", "amd64", 16, "-1.7976931348623157e+308 (por componente)", "1.7976931348623157e+308 (por componente)"},
+		{"Celsius", "amd64", 8, "-1.7976931348623157e+308", "1.7976931348623157e+308"},
+		{"bool", "amd64", 1, "", ""},
+		{"string", "amd64", 16, "", ""},
+		{"Registro", "amd64", 32, "", ""},
+	}
+	for _, tt := range tests {
+		info, err := resolver(t, tt.arch).Lookup(tt.expr)
+		if err != nil {
+			t.Errorf("Lookup(%q): %v", tt.expr, err)
+			continue
+		}
+		if info.Size != tt.size || info.Min != tt.min || info.Max != tt.max {
+			t.Errorf("%s en %s: tamaño %d, rango [%s, %s], se esperaba %d, [%s, %s]",
+				tt.expr, tt.arch, info.Size, info.Min, info.Max, tt.size, tt.min, tt.max)
+		}
+	}
+}
+
+func TestLiteral(t *testing.T) {
+	r := &typeinfo.Resolver{Sizes: types.SizesFor("gc", "amd64")}
+	tests := []struct{ expr, typ, literal string }{
+		{"3.14", "float64", "3.14"},
+		{"'a'", "rune", "97"},
+		{"1 << 10", "int", "1024"},
+		{`"hola"`, "string", `"hola"`},
+	}
+	for _, tt := range tests {
+		info, err := r.Lookup(tt.expr)
+		if err != nil {
+			t.Errorf("Lookup(%q): %v", tt.expr, err)
+			continue
+		}
+		if got := r.TypeString(info.Type); got != tt.typ || info.Literal != tt.literal {
+			t.Errorf("Lookup(%q) = %s %s, se esperaba %s %s", tt.expr, got, info.Literal, tt.typ, tt.literal)
+		}
+	}
+}
+
+func TestUnknownType(t *testing.T) {
+	for _, expr := range []string{"NoExiste", "[]NoExiste", "Registro +"} {
+		if _, err := resolver(t, "amd64").Lookup(expr); !errors.Is(err, typeinfo.ErrUnknownType) {
+			t.Errorf("Lookup(%q): error %v, se esperaba ErrUnknownType", expr, err)
+		}
+	}
+}
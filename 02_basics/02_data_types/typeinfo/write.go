@@ -0,0 +1,70 @@
+package typeinfo
+
+import (
+	"fmt"
+	"go/types"
+	"io"
+	"text/tabwriter"
+)
+
+// Write escribe la descripción de info en formato de texto.
+func (r *Resolver) Write(w io.Writer, info *Info) error {
+	name := r.TypeString(info.Type)
+	if info.Literal != "" {
+		fmt.Fprintf(w, "%s  (literal %s, tipo por defecto %s)\n", info.Expr, info.Literal, name)
+	} else if underlying := r.TypeString(info.Type.Underlying()); underlying != name {
+		fmt.Fprintf(w, "%s  (%s)\n", name, underlying)
+	} else {
+		fmt.Fprintln(w, name)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "  Tamaño:\t%d bytes\n", info.Size)
+	fmt.Fprintf(tw, "  Alineación:\t%d bytes\n", info.Align)
+	if info.Min != "" {
+		fmt.Fprintf(tw, "  Mínimo:\t%s\n", info.Min)
+		fmt.Fprintf(tw, "  Máximo:\t%s\n", info.Max)
+	}
+	fmt.Fprintf(tw, "  Valor cero:\t%s\n", info.Zero)
+	switch {
+	case info.Comparable && types.IsInterface(info.Type):
+		fmt.Fprintf(tw, "  Comparable:\tsí, pero == entra en pánico si el valor dinámico no lo es\n")
+	case info.Comparable:
+		fmt.Fprintf(tw, "  Comparable:\tsí, admite == y sirve como clave de mapa\n")
+	default:
+		fmt.Fprintf(tw, "  Comparable:\tno, contiene slices, mapas o funciones\n")
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if info.Fields != nil {
+		fmt.Fprintln(w)
+		r.writeFields(w, info.Fields, info.TrailingPadding)
+		if info.Suggested != nil {
+			fmt.Fprintf(w, "\n  Orden sugerido (%d bytes, ahorra %d):\n", info.SuggestedSize, info.Size-info.SuggestedSize)
+			var trailing int64
+			if n := len(info.Suggested); n > 0 {
+				last := info.Suggested[n-1]
+				trailing = info.SuggestedSize - last.Offset - last.Size
+			}
+			r.writeFields(w, info.Suggested, trailing)
+		} else if info.Padding() > 0 {
+			fmt.Fprintln(w, "\n  El orden actual ya tiene el mínimo relleno posible.")
+		}
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+func (r *Resolver) writeFields(w io.Writer, fields []Field, trailing int64) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "  Campo\tTipo\tOffset\tTamaño\tAlineación\tRelleno")
+	for _, f := range fields {
+		fmt.Fprintf(tw, "  %s\t%s\t%d\t%d\t%d\t%d\n", f.Name, r.TypeString(f.Type), f.Offset, f.Size, f.Align, f.Padding)
+	}
+	if trailing > 0 {
+		fmt.Fprintf(tw, "  (final)\t\t\t\t\t%d\n", trailing)
+	}
+	tw.Flush()
+}
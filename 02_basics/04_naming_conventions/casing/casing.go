@@ -0,0 +1,135 @@
+// Package casing separa identificadores en palabras y los convierte entre
+// los estilos de la lección: camelCase, PascalCase, snake_case, kebab-case,
+// SCREAMING_SNAKE_CASE y Title Case. Las siglas siguen la convención de Go:
+// userId se escribe userID y HttpServer, HTTPServer.
+package casing
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Initialisms son las siglas que Go escribe siempre en un solo caso. Es la
+// lista de golint, la misma que usan staticcheck y gopls.
+var Initialisms = map[string]bool{
+	"ACL": true, "API": true, "ASCII": true, "CPU": true, "CSS": true,
+	"DNS": true, "EOF": true, "GUID": true, "HTML": true, "HTTP": true,
+	"HTTPS": true, "ID": true, "IP": true, "JSON": true, "LHS": true,
+	"QPS": true, "RAM": true, "RHS": true, "RPC": true, "SLA": true,
+	"SMTP": true, "SQL": true, "SSH": true, "TCP": true, "TLS": true,
+	"TTL": true, "UDP": true, "UI": true, "UID": true, "UUID": true,
+	"URI": true, "URL": true, "UTF8": true, "VM": true, "XML": true,
+	"XMPP": true, "XSRF": true, "XSS": true,
+}
+
+// Case es un estilo de escritura.
+type Case int
+
+const (
+	Camel     Case = iota // userID
+	Pascal                // UserID
+	Snake                 // user_id
+	Kebab                 // user-id
+	Screaming             // USER_ID
+	Title                 // User ID
+)
+
+var caseNames = [...]string{"camel", "pascal", "snake", "kebab", "screaming", "title"}
+
+func (c Case) String() string {
+	if c < 0 || int(c) >= len(caseNames) {
+		return fmt.Sprintf("Case(%d)", int(c))
+	}
+	return caseNames[c]
+}
+
+// Cases son todos los estilos, en el orden de las constantes.
+var Cases = []Case{Camel, Pascal, Snake, Kebab, Screaming, Title}
+
+// ErrUnknownCase se devuelve cuando ParseCase no reconoce el nombre.
+var ErrUnknownCase = errors.New("casing: estilo desconocido")
+
+// ParseCase convierte un nombre como "snake" o "PascalCase" en un Case.
+func ParseCase(name string) (Case, error) {
+	n := strings.ToLower(strings.TrimSuffix(strings.TrimSuffix(name, "Case"), "case"))
+	n = strings.Trim(n, "_- ")
+	switch n {
+	case "lower_camel", "mixed":
+		return Camel, nil
+	case "upper_camel":
+		return Pascal, nil
+	case "screaming_snake", "upper", "constant":
+		return Screaming, nil
+	}
+	for i, c := range caseNames {
+		if n == c {
+			return Case(i), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %q", ErrUnknownCase, name)
+}
+
+// Convert escribe s en el estilo c.
+func Convert(s string, c Case) string {
+	words := Split(s)
+	switch c {
+	case Camel, Pascal:
+		var b strings.Builder
+		for i, w := range words {
+			if i == 0 && c == Camel {
+				b.WriteString(strings.ToLower(w))
+			} else {
+				b.WriteString(capitalize(w))
+			}
+		}
+		return b.String()
+	case Snake, Kebab:
+		sep := "_"
+		if c == Kebab {
+			sep = "-"
+		}
+		return strings.ToLower(strings.Join(words, sep))
+	case Screaming:
+		return strings.ToUpper(strings.Join(words, "_"))
+	case Title:
+		for i, w := range words {
+			words[i] = capitalize(w)
+		}
+		return strings.Join(words, " ")
+	}
+	return s
+}
+
+// Atajos de Convert para cada estilo.
+
+func CamelCase(s string) string     { return Convert(s, Camel) }
+func PascalCase(s string) string    { return Convert(s, Pascal) }
+func SnakeCase(s string) string     { return Convert(s, Snake) }
+func KebabCase(s string) string     { return Convert(s, Kebab) }
+func ScreamingCase(s string) string { return Convert(s, Screaming) }
+func TitleCase(s string) string     { return Convert(s, Title) }
+
+// capitalize escribe la palabra con mayúscula inicial, o toda en mayúsculas
+// si es una sigla o ya venía así con dígitos, como 2FA o MP3.
+func capitalize(w string) string {
+	upper := strings.ToUpper(w)
+	switch {
+	case Initialisms[upper], w == upper && hasDigit(w) && hasLetter(w):
+		return upper
+	case isInitialismPlural(w):
+		return upper[:len(upper)-1] + "s"
+	}
+	r := []rune(strings.ToLower(w))
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+// isInitialismPlural reconoce plurales como IDs o URLs.
+func isInitialismPlural(w string) bool {
+	return len(w) > 2 && (w[len(w)-1] == 's' || w[len(w)-1] == 'S') && Initialisms[strings.ToUpper(w[:len(w)-1])]
+}
+
+func hasDigit(s string) bool  { return strings.IndexFunc(s, unicode.IsDigit) >= 0 }
+func hasLetter(s string) bool { return strings.IndexFunc(s, unicode.IsLetter) >= 0 }
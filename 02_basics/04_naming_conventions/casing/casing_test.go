@@ -0,0 +1,105 @@
+package casing
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"", nil},
+		{"a", []string{"a"}},
+		{"ID", []string{"ID"}},
+		{"employeeId", []string{"employee", "Id"}},
+		{"userID2FA", []string{"user", "ID", "2FA"}},
+		{"HTTP2Server", []string{"HTTP2", "Server"}},
+		{"getHTTPSURLs", []string{"get", "HTTPS", "URLs"}},
+		{"userIDs", []string{"user", "IDs"}},
+		{"XMLHttpRequest", []string{"XML", "Http", "Request"}},
+		{"base64Encode", []string{"base64", "Encode"}},
+		{"oauth2Token", []string{"oauth2", "Token"}},
+		{"texto_num", []string{"texto", "num"}},
+		{"MAX_SIZE", []string{"MAX", "SIZE"}},
+		{"first-name", []string{"first", "name"}},
+		{"Hello World", []string{"Hello", "World"}},
+		{"__private", []string{"private"}},
+	}
+	for _, tt := range tests {
+		got := Split(tt.in)
+		if len(got) == 0 && len(tt.want) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Split(%q) = %q, se esperaba %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		in   string
+		want map[Case]string
+	}{
+		{"userID2FA", map[Case]string{
+			Camel: "userID2FA", Pascal: "UserID2FA", Snake: "user_id_2fa",
+			Kebab: "user-id-2fa", Screaming: "USER_ID_2FA", Title: "User ID 2FA",
+		}},
+		{"HTTP2Server", map[Case]string{
+			Camel: "http2Server", Pascal: "HTTP2Server", Snake: "http2_server",
+			Kebab: "http2-server", Screaming: "HTTP2_SERVER", Title: "HTTP2 Server",
+		}},
+		{"getHTTPSURLs", map[Case]string{
+			Camel: "getHTTPSURLs", Pascal: "GetHTTPSURLs", Snake: "get_https_urls",
+			Kebab: "get-https-urls", Screaming: "GET_HTTPS_URLS", Title: "Get HTTPS URLs",
+		}},
+		{"employeeId", map[Case]string{
+			Camel: "employeeID", Pascal: "EmployeeID", Snake: "employee_id",
+			Kebab: "employee-id", Screaming: "EMPLOYEE_ID", Title: "Employee ID",
+		}},
+		{"XMLHttpRequest", map[Case]string{
+			Camel: "xmlHTTPRequest", Pascal: "XMLHTTPRequest", Snake: "xml_http_request",
+			Kebab: "xml-http-request", Screaming: "XML_HTTP_REQUEST", Title: "XML HTTP Request",
+		}},
+		{"MAX_SIZE", map[Case]string{
+			Camel: "maxSize", Pascal: "MaxSize", Snake: "max_size",
+			Kebab: "max-size", Screaming: "MAX_SIZE", Title: "Max Size",
+		}},
+		{"first-name", map[Case]string{
+			Camel: "firstName", Pascal: "FirstName", Snake: "first_name",
+			Kebab: "first-name", Screaming: "FIRST_NAME", Title: "First Name",
+		}},
+		{"ID", map[Case]string{
+			Camel: "id", Pascal: "ID", Snake: "id",
+			Kebab: "id", Screaming: "ID", Title: "ID",
+		}},
+		{"", map[Case]string{
+			Camel: "", Pascal: "", Snake: "",
+			Kebab: "", Screaming: "", Title: "",
+		}},
+	}
+	for _, tt := range tests {
+		for _, c := range Cases {
+			want, ok := tt.want[c]
+			if !ok {
+				t.Fatalf("falta el caso %s de %q en la tabla", c, tt.in)
+			}
+			if got := Convert(tt.in, c); got != want {
+				t.Errorf("Convert(%q, %s) = %q, se esperaba %q", tt.in, c, got, want)
+			}
+		}
+	}
+}
+
+func TestConvertIdempotent(t *testing.T) {
+	for _, in := range []string{"userID2FA", "getHTTPSURLs", "XMLHttpRequest", "texto_num"} {
+		for _, c := range Cases {
+			once := Convert(in, c)
+			if twice := Convert(once, c); twice != once {
+				t.Errorf("Convert(Convert(%q, %s)) = %q, se esperaba %q", in, c, twice, once)
+			}
+		}
+	}
+}
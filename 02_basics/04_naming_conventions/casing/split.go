@@ -0,0 +1,166 @@
+package casing
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Split separa un identificador en palabras, conservando su caso original.
+//
+// Se corta en los separadores (_, -, espacios, puntos) y en los cambios de
+// caso:
+//
+//	userID2FA  → user ID 2FA
+//	HTTPServer → HTTP Server
+//	userIDs    → user IDs
+//	base64URL  → base64 URL
+//	MP3Player  → MP3 Player
+//
+// Los dígitos se quedan con la palabra anterior, salvo que esta sea una
+// sigla conocida: entonces empiezan una palabra nueva que incluye las
+// mayúsculas que siguen, como en 2FA.
+func Split(s string) []string {
+	var words []string
+	for _, chunk := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		words = append(words, splitChunk(chunk)...)
+	}
+	return words
+}
+
+type runClass int
+
+const (
+	lower runClass = iota
+	upper
+	digit
+)
+
+func classOf(r rune) runClass {
+	switch {
+	case unicode.IsDigit(r):
+		return digit
+	case unicode.IsUpper(r):
+		return upper
+	}
+	return lower // Incluye letras sin caso
+}
+
+// runs parte chunk en tramos de minúsculas, mayúsculas o dígitos.
+func runs(chunk string) (parts []string, classes []runClass) {
+	r := []rune(chunk)
+	start := 0
+	for i := 1; i <= len(r); i++ {
+		if i == len(r) || classOf(r[i]) != classOf(r[start]) {
+			parts = append(parts, string(r[start:i]))
+			classes = append(classes, classOf(r[start]))
+			start = i
+		}
+	}
+	return parts, classes
+}
+
+func splitChunk(chunk string) []string {
+	parts, classes := runs(chunk)
+	var words []string
+	var current strings.Builder
+	flush := func() {
+		if current.Len() > 0 {
+			words = append(words, current.String())
+			current.Reset()
+		}
+	}
+
+	for i := 0; i < len(parts); i++ {
+		part := parts[i]
+		switch classes[i] {
+		case upper:
+			nextLower := i+1 < len(parts) && classes[i+1] == lower
+			switch {
+			case nextLower && isPluralS(parts, i):
+				// userIDs: la s es el plural de la última sigla
+				flush()
+				acronyms := splitAcronyms(part)
+				words = append(words, acronyms[:len(acronyms)-1]...)
+				words = append(words, acronyms[len(acronyms)-1]+parts[i+1])
+				i++
+			case nextLower:
+				// HTTPServer: la última mayúscula empieza la palabra siguiente
+				r := []rune(part)
+				flush()
+				if len(r) > 1 {
+					words = append(words, splitAcronyms(string(r[:len(r)-1]))...)
+				}
+				current.WriteString(string(r[len(r)-1:]) + parts[i+1])
+				i++
+			case startsWithDigit(current.String()):
+				// 2FA
+				current.WriteString(part)
+			default:
+				flush()
+				acronyms := splitAcronyms(part)
+				words = append(words, acronyms[:len(acronyms)-1]...)
+				current.WriteString(acronyms[len(acronyms)-1])
+			}
+		case lower:
+			if current.Len() > 0 && !endsWithDigit(current.String()) {
+				flush()
+			}
+			current.WriteString(part)
+		case digit:
+			// Tras una sigla, los dígitos seguidos de mayúsculas forman otra
+			// sigla (ID2FA); si no, se pegan a ella (HTTP2Server)
+			prev := current.String()
+			nextAcronym := i+1 < len(parts) && classes[i+1] == upper &&
+				(i+2 == len(parts) || classes[i+2] != lower)
+			if prev != "" && Initialisms[strings.ToUpper(prev)] && nextAcronym {
+				flush()
+			}
+			current.WriteString(part)
+		}
+	}
+	flush()
+	return words
+}
+
+// isPluralS indica si parts[i] termina en una sigla seguida solo de una s,
+// como en URLs o HTTPSURLs.
+func isPluralS(parts []string, i int) bool {
+	if parts[i+1] != "s" {
+		return false
+	}
+	acronyms := splitAcronyms(parts[i])
+	return Initialisms[acronyms[len(acronyms)-1]]
+}
+
+// splitAcronyms separa una tira de mayúsculas en siglas conocidas, tomando
+// siempre la más larga: HTTPSURL → HTTPS URL. Si no se puede cubrir entera
+// con siglas la devuelve sin cortar.
+func splitAcronyms(run string) []string {
+	var out []string
+	for rest := run; rest != ""; {
+		n := 0
+		for end := len(rest); end > 0; end-- {
+			if Initialisms[rest[:end]] {
+				n = end
+				break
+			}
+		}
+		if n == 0 {
+			return []string{run}
+		}
+		out = append(out, rest[:n])
+		rest = rest[n:]
+	}
+	return out
+}
+
+func startsWithDigit(s string) bool {
+	return s != "" && unicode.IsDigit([]rune(s)[0])
+}
+
+func endsWithDigit(s string) bool {
+	r := []rune(s)
+	return len(r) > 0 && unicode.IsDigit(r[len(r)-1])
+}
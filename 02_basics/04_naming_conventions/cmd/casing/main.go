@@ -0,0 +1,70 @@
+// casing convierte identificadores entre estilos, uno por línea.
+//
+// Ej: echo employeeId | go run ./02_basics/04_naming_conventions/cmd/casing -to snake
+//
+//	go run ./02_basics/04_naming_conventions/cmd/casing userID2FA texto_num
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/FepDev25/gobootcamp/02_basics/04_naming_conventions/casing"
+)
+
+func main() {
+	to := flag.String("to", "", "estilo de salida: camel, pascal, snake, kebab, screaming o title; vacío muestra todos")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Uso: casing [-to estilo] [identificador...]")
+		fmt.Fprintln(os.Stderr, "\nSin identificadores lee uno por línea de stdin.")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	convert := func(s string) string { return all(s) }
+	if *to != "" {
+		c, err := casing.ParseCase(*to)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Error:", err)
+			os.Exit(2)
+		}
+		convert = func(s string) string { return casing.Convert(s, c) }
+	}
+
+	if flag.NArg() > 0 {
+		for _, arg := range flag.Args() {
+			fmt.Println(convert(arg))
+		}
+		return
+	}
+
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			fmt.Println()
+			continue
+		}
+		fmt.Println(convert(line))
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+// all muestra las palabras y todos los estilos de s.
+func all(s string) string {
+	var b strings.Builder
+	tw := tabwriter.NewWriter(&b, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "%s\tpalabras: %s\n", s, strings.Join(casing.Split(s), " · "))
+	for _, c := range casing.Cases {
+		fmt.Fprintf(tw, "  %s\t%s\n", c, casing.Convert(s, c))
+	}
+	tw.Flush()
+	return b.String()
+}
@@ -0,0 +1,57 @@
+// employee muestra el encapsulamiento del paquete employee: los datos
+// inválidos se rechazan al crear, modificar o decodificar un empleado.
+//
+// Ej: go run ./02_basics/04_naming_conventions/cmd/employee
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/FepDev25/gobootcamp/02_basics/04_naming_conventions/employee"
+)
+
+func main() {
+	var list []*employee.Employee
+	for _, data := range []struct {
+		id          int
+		first, last string
+	}{
+		{3, "Felipe", "Peralta"},
+		{1, "Emilia", "andrade"},
+		{2, "Karen", "Peralta"},
+		{0, "Sin", "ID"},
+		{4, "  ", "Vacío"},
+	} {
+		e, err := employee.New(data.id, data.first, data.last)
+		if err != nil {
+			fmt.Println("Rechazado:", err)
+			continue
+		}
+		list = append(list, e)
+	}
+
+	employee.SortByName(list)
+	fmt.Println("Por nombre:", list)
+	employee.SortByID(list)
+	fmt.Println("Por ID:", list)
+
+	// Un setter que falla deja al empleado como estaba
+	if err := list[0].SetLastName(""); err != nil {
+		fmt.Println("Rechazado:", err)
+	}
+	fmt.Println("Sin cambios:", list[0])
+
+	data, err := json.Marshal(list)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+	fmt.Println("JSON:", string(data))
+
+	var e employee.Employee
+	if err := json.Unmarshal([]byte(`{"id":-5,"first_name":"X","last_name":"Y"}`), &e); err != nil {
+		fmt.Println("Rechazado:", err)
+	}
+}
@@ -0,0 +1,136 @@
+// Package employee es el Employee de naming_conventions.go convertido en un
+// tipo de dominio: los campos no exportados solo se modifican a través de
+// métodos que validan. Fuera del paquete se puede declarar un Employee vacío
+// (su valor cero, que no es válido), pero no uno con datos inválidos.
+package employee
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxNameLen es la longitud máxima, en runas, de un nombre o apellido.
+const MaxNameLen = 50
+
+var (
+	ErrInvalidID   = errors.New("employee: el ID debe ser positivo")
+	ErrInvalidName = errors.New("employee: nombre inválido")
+)
+
+// Employee es un empleado con ID positivo y nombre y apellido no vacíos.
+//
+// El valor cero (var e Employee o Employee{}) compila pero no es válido: su
+// ID es 0 y los nombres están vacíos. Los empleados se crean con New o se
+// decodifican de JSON, que también valida; los setters sí se pueden usar
+// sobre el valor cero.
+type Employee struct {
+	id        int
+	firstName string
+	lastName  string
+}
+
+// New crea un empleado. Los nombres se guardan sin los espacios de los
+// extremos.
+func New(id int, firstName, lastName string) (*Employee, error) {
+	if id <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidID, id)
+	}
+	e := &Employee{id: id}
+	if err := e.SetFirstName(firstName); err != nil {
+		return nil, err
+	}
+	if err := e.SetLastName(lastName); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// ID no tiene setter: identifica al empleado y no cambia.
+func (e *Employee) ID() int { return e.id }
+
+func (e *Employee) FirstName() string { return e.firstName }
+
+func (e *Employee) LastName() string { return e.lastName }
+
+// SetFirstName cambia el nombre. Si name no es válido, el empleado queda
+// como estaba.
+func (e *Employee) SetFirstName(name string) error {
+	name, err := validName("nombre", name)
+	if err != nil {
+		return err
+	}
+	e.firstName = name
+	return nil
+}
+
+// SetLastName cambia el apellido. Si name no es válido, el empleado queda
+// como estaba.
+func (e *Employee) SetLastName(name string) error {
+	name, err := validName("apellido", name)
+	if err != nil {
+		return err
+	}
+	e.lastName = name
+	return nil
+}
+
+// FullName devuelve "Nombre Apellido". En el valor cero devuelve "".
+func (e *Employee) FullName() string {
+	return strings.TrimSpace(e.firstName + " " + e.lastName)
+}
+
+// String devuelve "#ID Nombre Apellido", o "#0" en el valor cero.
+func (e *Employee) String() string {
+	if name := e.FullName(); name != "" {
+		return fmt.Sprintf("#%d %s", e.id, name)
+	}
+	return fmt.Sprintf("#%d", e.id)
+}
+
+func validName(field, name string) (string, error) {
+	name = strings.TrimSpace(name)
+	switch {
+	case name == "":
+		return "", fmt.Errorf("%w: el %s está vacío", ErrInvalidName, field)
+	case !utf8.ValidString(name):
+		return "", fmt.Errorf("%w: el %s no es UTF-8 válido", ErrInvalidName, field)
+	case utf8.RuneCountInString(name) > MaxNameLen:
+		return "", fmt.Errorf("%w: el %s supera las %d letras", ErrInvalidName, field, MaxNameLen)
+	case strings.IndexFunc(name, unicode.IsControl) >= 0:
+		return "", fmt.Errorf("%w: el %s tiene caracteres de control", ErrInvalidName, field)
+	}
+	return name, nil
+}
+
+// employeeJSON es la forma en JSON; encoding/json no ve los campos no
+// exportados de Employee.
+type employeeJSON struct {
+	ID        int    `json:"id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+}
+
+// MarshalJSON usa receptor por valor para que un Employee sin puntero, ej.
+// dentro de un mapa o un slice, también se codifique con sus campos.
+func (e Employee) MarshalJSON() ([]byte, error) {
+	return json.Marshal(employeeJSON{ID: e.id, FirstName: e.firstName, LastName: e.lastName})
+}
+
+// UnmarshalJSON valida igual que New; si el JSON no es válido, e queda como
+// estaba.
+func (e *Employee) UnmarshalJSON(data []byte) error {
+	var v employeeJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	valid, err := New(v.ID, v.FirstName, v.LastName)
+	if err != nil {
+		return err
+	}
+	*e = *valid
+	return nil
+}
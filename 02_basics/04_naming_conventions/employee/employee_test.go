@@ -0,0 +1,229 @@
+package employee_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/FepDev25/gobootcamp/02_basics/04_naming_conventions/employee"
+)
+
+// Las pruebas están en employee_test, fuera del paquete: solo usan lo
+// exportado, igual que cualquier código que importe employee.
+
+func mustNew(t *testing.T, id int, first, last string) *employee.Employee {
+	t.Helper()
+	e, err := employee.New(id, first, last)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func TestNew(t *testing.T) {
+	e := mustNew(t, 7, "  Felipe ", "Peralta\t")
+	if e.ID() != 7 || e.FirstName() != "Felipe" || e.LastName() != "Peralta" {
+		t.Errorf("New = %d %q %q", e.ID(), e.FirstName(), e.LastName())
+	}
+	if got := e.FullName(); got != "Felipe Peralta" {
+		t.Errorf("FullName = %q", got)
+	}
+	if got := e.String(); got != "#7 Felipe Peralta" {
+		t.Errorf("String = %q", got)
+	}
+	if got := mustNew(t, 1, strings.Repeat("ñ", employee.MaxNameLen), "X").FirstName(); len([]rune(got)) != employee.MaxNameLen {
+		t.Errorf("un nombre de %d runas debería aceptarse", employee.MaxNameLen)
+	}
+}
+
+func TestNewRejects(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          int
+		first, last string
+		err         error
+	}{
+		{"ID cero", 0, "Felipe", "Peralta", employee.ErrInvalidID},
+		{"ID negativo", -1, "Felipe", "Peralta", employee.ErrInvalidID},
+		{"nombre vacío", 1, "", "Peralta", employee.ErrInvalidName},
+		{"nombre en blanco", 1, " \t ", "Peralta", employee.ErrInvalidName},
+		{"apellido vacío", 1, "Felipe", "", employee.ErrInvalidName},
+		{"nombre largo", 1, strings.Repeat("a", employee.MaxNameLen+1), "Peralta", employee.ErrInvalidName},
+		{"caracter de control", 1, "Fel\x00ipe", "Peralta", employee.ErrInvalidName},
+		{"salto de línea", 1, "Felipe", "Per\nalta", employee.ErrInvalidName},
+		{"UTF-8 inválido", 1, "Fel\xffipe", "Peralta", employee.ErrInvalidName},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := employee.New(tt.id, tt.first, tt.last)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("error = %v, se esperaba %v", err, tt.err)
+			}
+			if e != nil {
+				t.Errorf("New devolvió %v junto con el error", e)
+			}
+		})
+	}
+}
+
+func TestSettersKeepValueOnError(t *testing.T) {
+	e := mustNew(t, 1, "Felipe", "Peralta")
+	for _, bad := range []string{"", "   ", strings.Repeat("x", employee.MaxNameLen+1), "a\x07b"} {
+		if err := e.SetFirstName(bad); !errors.Is(err, employee.ErrInvalidName) {
+			t.Errorf("SetFirstName(%q) = %v", bad, err)
+		}
+		if err := e.SetLastName(bad); !errors.Is(err, employee.ErrInvalidName) {
+			t.Errorf("SetLastName(%q) = %v", bad, err)
+		}
+	}
+	if e.String() != "#1 Felipe Peralta" {
+		t.Errorf("los setters fallidos modificaron el empleado: %v", e)
+	}
+
+	if err := e.SetFirstName(" Karen "); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.SetLastName("Perez"); err != nil {
+		t.Fatal(err)
+	}
+	if e.String() != "#1 Karen Perez" {
+		t.Errorf("después de los setters: %v", e)
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	var e employee.Employee
+	if e.ID() != 0 || e.FullName() != "" || e.String() != "#0" {
+		t.Errorf("valor cero: ID %d, FullName %q, String %q", e.ID(), e.FullName(), e.String())
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	e := mustNew(t, 3, "Emilia", "Andrade")
+	data, err := json.Marshal(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"id":3,"first_name":"Emilia","last_name":"Andrade"}`; string(data) != want {
+		t.Errorf("Marshal = %s, se esperaba %s", data, want)
+	}
+
+	var got employee.Employee
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != *e {
+		t.Errorf("Unmarshal = %v, se esperaba %v", &got, e)
+	}
+}
+
+func TestMarshalValue(t *testing.T) {
+	e := mustNew(t, 3, "Emilia", "Andrade")
+	const want = `{"id":3,"first_name":"Emilia","last_name":"Andrade"}`
+
+	data, err := json.Marshal(*e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != want {
+		t.Errorf("Marshal(valor) = %s, se esperaba %s", data, want)
+	}
+
+	byKey := map[string]employee.Employee{"a": *e}
+	data, err = json.Marshal(byKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"a":`+want+`}` {
+		t.Errorf("Marshal(mapa) = %s, se esperaba {\"a\":%s}", data, want)
+	}
+
+	var back map[string]employee.Employee
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatal(err)
+	}
+	if back["a"] != *e {
+		t.Errorf("ida y vuelta del mapa = %v, se esperaba %v", back["a"], *e)
+	}
+
+	list := []employee.Employee{*e, *mustNew(t, 4, "Luis", "Mora")}
+	data, err = json.Marshal(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var backList []employee.Employee
+	if err := json.Unmarshal(data, &backList); err != nil {
+		t.Fatal(err)
+	}
+	if len(backList) != 2 || backList[0] != list[0] || backList[1] != list[1] {
+		t.Errorf("ida y vuelta del slice = %v, se esperaba %v", backList, list)
+	}
+}
+
+func TestUnmarshalRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		err  error // nil si basta con que falle
+	}{
+		{"null", `null`, employee.ErrInvalidID},
+		{"objeto vacío", `{}`, employee.ErrInvalidID},
+		{"ID negativo", `{"id":-5,"first_name":"X","last_name":"Y"}`, employee.ErrInvalidID},
+		{"nombre vacío", `{"id":5,"first_name":"","last_name":"Y"}`, employee.ErrInvalidName},
+		{"nombre largo", fmt.Sprintf(`{"id":5,"first_name":%q,"last_name":"Y"}`, strings.Repeat("x", 51)), employee.ErrInvalidName},
+		{"tipo incorrecto", `{"id":"5","first_name":"X","last_name":"Y"}`, nil},
+		{"JSON inválido", `{"id":5,`, nil},
+		{"arreglo", `[1,2]`, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := mustNew(t, 1, "Felipe", "Peralta")
+			// Se llama directamente: json.Unmarshal trata algunos casos,
+			// como el JSON inválido, antes de llegar a UnmarshalJSON
+			err := e.UnmarshalJSON([]byte(tt.data))
+			if err == nil || (tt.err != nil && !errors.Is(err, tt.err)) {
+				t.Errorf("UnmarshalJSON(%s) = %v, se esperaba %v", tt.data, err, tt.err)
+			}
+			if e.String() != "#1 Felipe Peralta" {
+				t.Errorf("UnmarshalJSON(%s) modificó el empleado: %v", tt.data, e)
+			}
+
+			if err := json.Unmarshal([]byte(tt.data), e); err == nil {
+				t.Errorf("json.Unmarshal(%s) no devolvió un error", tt.data)
+			}
+			if e.String() != "#1 Felipe Peralta" {
+				t.Errorf("json.Unmarshal(%s) modificó el empleado: %v", tt.data, e)
+			}
+		})
+	}
+}
+
+func TestSort(t *testing.T) {
+	list := []*employee.Employee{
+		mustNew(t, 3, "Felipe", "Peralta"),
+		mustNew(t, 1, "Emilia", "andrade"),
+		mustNew(t, 4, "Karen", "Peralta"),
+		mustNew(t, 2, "felipe", "Peralta"),
+	}
+	str := func() string { return fmt.Sprint(list) }
+
+	employee.SortByName(list)
+	// Sin distinguir mayúsculas; con el mismo nombre decide el ID
+	if want := "[#1 Emilia andrade #2 felipe Peralta #3 Felipe Peralta #4 Karen Peralta]"; str() != want {
+		t.Errorf("SortByName = %s, se esperaba %s", str(), want)
+	}
+
+	list[0], list[3] = list[3], list[0]
+	employee.SortByID(list)
+	for i, e := range list {
+		if e.ID() != i+1 {
+			t.Errorf("SortByID: posición %d tiene ID %d", i, e.ID())
+		}
+	}
+
+	if employee.CompareByName(list[1], list[2]) >= 0 || employee.CompareByID(list[2], list[1]) <= 0 {
+		t.Error("CompareByName o CompareByID no ordenan como SortByName y SortByID")
+	}
+}
@@ -0,0 +1,33 @@
+package employee
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// CompareByName ordena por apellido, luego por nombre, sin distinguir
+// mayúsculas, y por ID si los nombres coinciden. Sirve para
+// slices.SortFunc.
+func CompareByName(a, b *Employee) int {
+	return cmp.Or(
+		cmp.Compare(strings.ToLower(a.lastName), strings.ToLower(b.lastName)),
+		cmp.Compare(strings.ToLower(a.firstName), strings.ToLower(b.firstName)),
+		cmp.Compare(a.id, b.id),
+	)
+}
+
+// CompareByID ordena por ID.
+func CompareByID(a, b *Employee) int {
+	return cmp.Compare(a.id, b.id)
+}
+
+// SortByName ordena list con CompareByName.
+func SortByName(list []*Employee) {
+	slices.SortStableFunc(list, CompareByName)
+}
+
+// SortByID ordena list con CompareByID.
+func SortByID(list []*Employee) {
+	slices.SortFunc(list, CompareByID)
+}
@@ -0,0 +1,405 @@
+package directory_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/FepDev25/gobootcamp/03_project/01_employee_directory/directory"
+)
+
+// client hace peticiones a un servidor de prueba.
+type client struct {
+	t   *testing.T
+	url string
+}
+
+func newClient(t *testing.T, repo directory.Repository) *client {
+	t.Helper()
+	srv := httptest.NewServer(directory.NewHandler(repo))
+	t.Cleanup(srv.Close)
+	return &client{t: t, url: srv.URL}
+}
+
+type response struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+func (c *client) do(method, path, contentType, body string) response {
+	c.t.Helper()
+	req, err := http.NewRequest(method, c.url+path, strings.NewReader(body))
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	return response{status: resp.StatusCode, header: resp.Header, body: data}
+}
+
+func (c *client) json(method, path, body string) response {
+	c.t.Helper()
+	return c.do(method, path, "application/json", body)
+}
+
+type employeeJSON struct {
+	ID        int    `json:"id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+}
+
+type listJSON struct {
+	Items    []employeeJSON `json:"items"`
+	Page     int            `json:"page"`
+	PageSize int            `json:"page_size"`
+	Total    int            `json:"total"`
+}
+
+func decode[T any](t *testing.T, r response) T {
+	t.Helper()
+	var v T
+	if err := json.Unmarshal(r.body, &v); err != nil {
+		t.Fatalf("%s: %v", r.body, err)
+	}
+	return v
+}
+
+func expectStatus(t *testing.T, r response, status int) {
+	t.Helper()
+	if r.status != status {
+		t.Fatalf("estado %d, se esperaba %d: %s", r.status, status, r.body)
+	}
+}
+
+// repositories devuelve una implementación nueva de cada Repository.
+func repositories(t *testing.T) map[string]directory.Repository {
+	file, err := directory.OpenFile(filepath.Join(t.TempDir(), "empleados.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return map[string]directory.Repository{
+		"memoria": directory.NewMemoryRepository(),
+		"archivo": file,
+	}
+}
+
+func TestCRUD(t *testing.T) {
+	for name, repo := range repositories(t) {
+		t.Run(name, func(t *testing.T) {
+			c := newClient(t, repo)
+
+			r := c.json("POST", "/employees", `{"first_name":" Felipe ","last_name":"Peralta"}`)
+			expectStatus(t, r, http.StatusCreated)
+			created := decode[employeeJSON](t, r)
+			if created != (employeeJSON{1, "Felipe", "Peralta"}) {
+				t.Errorf("creado = %+v", created)
+			}
+			if loc := r.header.Get("Location"); loc != "/employees/1" {
+				t.Errorf("Location = %q", loc)
+			}
+
+			r = c.do("GET", "/employees/1", "", "")
+			expectStatus(t, r, http.StatusOK)
+			if got := decode[employeeJSON](t, r); got != created {
+				t.Errorf("GET = %+v, se esperaba %+v", got, created)
+			}
+
+			r = c.json("PUT", "/employees/1", `{"first_name":"Felipe","last_name":"Pérez"}`)
+			expectStatus(t, r, http.StatusOK)
+			if got := decode[employeeJSON](t, r); got.LastName != "Pérez" || got.ID != 1 {
+				t.Errorf("PUT = %+v", got)
+			}
+
+			r = c.do("DELETE", "/employees/1", "", "")
+			expectStatus(t, r, http.StatusNoContent)
+			expectStatus(t, c.do("GET", "/employees/1", "", ""), http.StatusNotFound)
+			expectStatus(t, c.do("DELETE", "/employees/1", "", ""), http.StatusNotFound)
+			expectStatus(t, c.json("PUT", "/employees/1", `{"first_name":"A","last_name":"B"}`), http.StatusNotFound)
+
+			// Los IDs no se reutilizan mientras el repositorio está abierto
+			r = c.json("POST", "/employees", `{"first_name":"Karen","last_name":"Perez"}`)
+			expectStatus(t, r, http.StatusCreated)
+			if got := decode[employeeJSON](t, r); got.ID != 2 {
+				t.Errorf("ID = %d, se esperaba 2", got.ID)
+			}
+		})
+	}
+}
+
+func seed(t *testing.T, c *client) {
+	t.Helper()
+	for _, body := range []string{
+		`{"first_name":"Felipe","last_name":"Peralta"}`,
+		`{"first_name":"Karen","last_name":"Perez"}`,
+		`{"first_name":"Emilia","last_name":"Andrade"}`,
+		`{"first_name":"Pedro","last_name":"Zambrano"}`,
+		`{"first_name":"Ana","last_name":"Ruiz"}`,
+	} {
+		expectStatus(t, c.json("POST", "/employees", body), http.StatusCreated)
+	}
+}
+
+func names(list listJSON) string {
+	var out []string
+	for _, e := range list.Items {
+		out = append(out, e.FirstName)
+	}
+	return strings.Join(out, ",")
+}
+
+func TestSearch(t *testing.T) {
+	c := newClient(t, directory.NewMemoryRepository())
+	seed(t, c)
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"", "Emilia,Felipe,Karen,Ana,Pedro"}, // Por apellido
+		{"pe", "Felipe,Karen,Pedro"},          // Apellido o nombre
+		{"PER", "Felipe,Karen"},               // Sin distinguir mayúsculas
+		{"felipe%20p", "Felipe"},              // Nombre completo
+		{"ana", "Ana"},
+		{"x", ""},
+	}
+	for _, tt := range tests {
+		r := c.do("GET", "/employees?q="+tt.query, "", "")
+		expectStatus(t, r, http.StatusOK)
+		list := decode[listJSON](t, r)
+		if got := names(list); got != tt.want {
+			t.Errorf("q=%s: %s, se esperaba %s", tt.query, got, tt.want)
+		}
+		if list.Total != len(list.Items) {
+			t.Errorf("q=%s: total %d con %d resultados", tt.query, list.Total, len(list.Items))
+		}
+	}
+
+	// Una búsqueda sin resultados devuelve una lista vacía, no null
+	if r := c.do("GET", "/employees?q=x", "", ""); !strings.Contains(string(r.body), `"items":[]`) {
+		t.Errorf("sin resultados: %s", r.body)
+	}
+}
+
+func TestPagination(t *testing.T) {
+	c := newClient(t, directory.NewMemoryRepository())
+	seed(t, c)
+
+	tests := []struct {
+		query string
+		want  string
+		page  int
+		size  int
+	}{
+		{"page_size=2", "Emilia,Felipe", 1, 2},
+		{"page=2&page_size=2", "Karen,Ana", 2, 2},
+		{"page=3&page_size=2", "Pedro", 3, 2},
+		{"page=4&page_size=2", "", 4, 2},
+		{"page_size=100", "Emilia,Felipe,Karen,Ana,Pedro", 1, 100},
+		{"q=pe&page=2&page_size=1", "Karen", 2, 1},
+	}
+	for _, tt := range tests {
+		r := c.do("GET", "/employees?"+tt.query, "", "")
+		expectStatus(t, r, http.StatusOK)
+		list := decode[listJSON](t, r)
+		if got := names(list); got != tt.want || list.Page != tt.page || list.PageSize != tt.size {
+			t.Errorf("%s: %s (página %d de %d), se esperaba %s (página %d de %d)",
+				tt.query, got, list.Page, list.PageSize, tt.want, tt.page, tt.size)
+		}
+	}
+
+	if list := decode[listJSON](t, c.do("GET", "/employees", "", "")); list.PageSize != directory.DefaultPageSize || list.Total != 5 {
+		t.Errorf("sin parámetros: page_size %d, total %d", list.PageSize, list.Total)
+	}
+
+	for _, query := range []string{"page=0", "page=-1", "page=a", "page_size=0", "page_size=101", "page_size=x"} {
+		r := c.do("GET", "/employees?"+query, "", "")
+		p := expectProblem(t, r, http.StatusBadRequest)
+		param := strings.SplitN(query, "=", 2)[0]
+		if len(p.InvalidParams) != 1 || p.InvalidParams[0].Name != param {
+			t.Errorf("%s: invalid-params = %+v", query, p.InvalidParams)
+		}
+	}
+}
+
+type problemJSON struct {
+	Type          string `json:"type"`
+	Title         string `json:"title"`
+	Status        int    `json:"status"`
+	Detail        string `json:"detail"`
+	Instance      string `json:"instance"`
+	InvalidParams []struct {
+		Name   string `json:"name"`
+		Reason string `json:"reason"`
+	} `json:"invalid-params"`
+}
+
+// expectProblem revisa que r sea un application/problem+json con el estado
+// indicado, también dentro del cuerpo.
+func expectProblem(t *testing.T, r response, status int) problemJSON {
+	t.Helper()
+	expectStatus(t, r, status)
+	if ct := r.header.Get("Content-Type"); ct != "application/problem+json" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	p := decode[problemJSON](t, r)
+	if p.Type != "about:blank" || p.Status != status || p.Title != http.StatusText(status) || p.Instance == "" {
+		t.Errorf("problema mal formado: %s", r.body)
+	}
+	return p
+}
+
+func TestProblems(t *testing.T) {
+	c := newClient(t, directory.NewMemoryRepository())
+	seed(t, c)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		ct     string
+		body   string
+		status int
+		params []string // Nombres en invalid-params
+	}{
+		{"JSON inválido", "POST", "/employees", "application/json", `{"first_name":`, http.StatusBadRequest, nil},
+		{"campo desconocido", "POST", "/employees", "application/json", `{"firstname":"A","last_name":"B"}`, http.StatusBadRequest, nil},
+		{"dos objetos", "POST", "/employees", "application/json", `{"first_name":"A","last_name":"B"} {}`, http.StatusBadRequest, nil},
+		{"paginación", "GET", "/employees?page=0&page_size=0", "", "", http.StatusBadRequest, []string{"page", "page_size"}},
+		{"ID inexistente", "GET", "/employees/99", "", "", http.StatusNotFound, nil},
+		{"ID no numérico", "GET", "/employees/abc", "", "", http.StatusNotFound, nil},
+		{"ruta desconocida", "GET", "/nada", "", "", http.StatusNotFound, nil},
+		{"método de colección", "PATCH", "/employees", "", "", http.StatusMethodNotAllowed, nil},
+		{"método de empleado", "POST", "/employees/1", "", "", http.StatusMethodNotAllowed, nil},
+		{"formulario", "POST", "/employees", "application/x-www-form-urlencoded", "first_name=A", http.StatusUnsupportedMediaType, nil},
+		{"nombres vacíos", "POST", "/employees", "application/json", `{"first_name":"","last_name":"  "}`, http.StatusUnprocessableEntity, []string{"first_name", "last_name"}},
+		{"apellido largo", "PUT", "/employees/1", "application/json", `{"first_name":"A","last_name":"` + strings.Repeat("x", 51) + `"}`, http.StatusUnprocessableEntity, []string{"last_name"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := c.do(tt.method, tt.path, tt.ct, tt.body)
+			p := expectProblem(t, r, tt.status)
+			var got []string
+			for _, ip := range p.InvalidParams {
+				got = append(got, ip.Name)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.params, ",") {
+				t.Errorf("invalid-params = %v, se esperaba %v", got, tt.params)
+			}
+			if tt.status == http.StatusMethodNotAllowed && r.header.Get("Allow") == "" {
+				t.Error("falta el encabezado Allow")
+			}
+		})
+	}
+
+	// Un PUT rechazado no modifica al empleado
+	got := decode[employeeJSON](t, c.do("GET", "/employees/1", "", ""))
+	if got.LastName != "Peralta" {
+		t.Errorf("el PUT inválido modificó al empleado: %+v", got)
+	}
+}
+
+func TestFileRepositoryReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "empleados.jsonl")
+	repo, err := directory.OpenFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range [][2]string{{"Felipe", "Peralta"}, {"Karen", "Perez"}, {"Emilia", "Andrade"}} {
+		if _, err := repo.Create(name[0], name[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := repo.Update(2, "Karen", "Pérez"); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Delete(1); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"id":2,"first_name":"Karen","last_name":"Pérez"}` + "\n" +
+		`{"id":3,"first_name":"Emilia","last_name":"Andrade"}` + "\n"
+	if string(data) != want {
+		t.Errorf("archivo:\n%s\nse esperaba:\n%s", data, want)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o644 {
+		t.Errorf("permisos %o, se esperaba 644", perm)
+	}
+
+	reopened, err := directory.OpenFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page, err := reopened.List(directory.Query{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Items) != 2 || page.Items[0].String() != "#3 Emilia Andrade" || page.Items[1].String() != "#2 Karen Pérez" {
+		t.Errorf("reabierto: %v", page.Items)
+	}
+	e, err := reopened.Create("Ana", "Ruiz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.ID() != 4 {
+		t.Errorf("ID tras reabrir = %d, se esperaba 4", e.ID())
+	}
+
+	// Los permisos del archivo se conservan en cada escritura
+	if err := os.Chmod(path, 0o640); err != nil {
+		t.Fatal(err)
+	}
+	if err := reopened.Delete(4); err != nil {
+		t.Fatal(err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o640 {
+		t.Errorf("permisos tras escribir: %v, %v", info.Mode().Perm(), err)
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("quedaron archivos temporales: %v", entries)
+	}
+}
+
+func TestOpenFileRejectsCorruptData(t *testing.T) {
+	for name, content := range map[string]string{
+		"JSON inválido": "{\"id\":1,\"first_name\":\"A\",\"last_name\":\"B\"}\n{\"id\":\n",
+		"ID repetido":   "{\"id\":1,\"first_name\":\"A\",\"last_name\":\"B\"}\n{\"id\":1,\"first_name\":\"C\",\"last_name\":\"D\"}\n",
+		"nombre vacío":  "{\"id\":1,\"first_name\":\"\",\"last_name\":\"B\"}\n",
+	} {
+		path := filepath.Join(t.TempDir(), "empleados.jsonl")
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := directory.OpenFile(path); err == nil {
+			t.Errorf("%s: OpenFile no devolvió un error", name)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package directory
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/FepDev25/gobootcamp/02_basics/04_naming_conventions/employee"
+)
+
+// FileRepository guarda los empleados en un archivo JSON lines, un empleado
+// por línea.
+//
+// Cada cambio reescribe el archivo completo en uno temporal y lo renombra,
+// así que tras un corte de luz o un panic el archivo tiene el estado
+// anterior o el nuevo, nunca uno a medias. Los datos también se mantienen
+// en memoria; el archivo solo se lee al abrirlo.
+type FileRepository struct {
+	path string
+
+	mu sync.RWMutex
+	s  *state
+}
+
+// OpenFile abre el repositorio guardado en path. Si el archivo no existe,
+// empieza vacío y lo crea con el primer cambio. Los IDs siguen desde el
+// mayor guardado, así que el de un empleado borrado al final puede volver
+// a usarse tras reabrir.
+func OpenFile(path string) (*FileRepository, error) {
+	r := &FileRepository{path: path, s: newState()}
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		var e employee.Employee
+		if err := json.Unmarshal(data, &e); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		if _, dup := r.s.employees[e.ID()]; dup {
+			return nil, fmt.Errorf("%s:%d: ID %d repetido", path, line, e.ID())
+		}
+		r.s.employees[e.ID()] = e
+		r.s.nextID = max(r.s.nextID, e.ID()+1)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// apply aplica change sobre una copia del estado y solo la adopta si llegó
+// al archivo, para que la memoria y el archivo no difieran.
+func (r *FileRepository) apply(change func(s *state) error) error {
+	next := r.s.clone()
+	if err := change(next); err != nil {
+		return err
+	}
+	if err := r.save(next); err != nil {
+		return err
+	}
+	r.s = next
+	return nil
+}
+
+// save reemplaza el archivo por el estado s. Si devuelve un error, el
+// archivo quedó como estaba.
+func (r *FileRepository) save(s *state) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	// El orden por ID hace que los cambios en el archivo sean fáciles de leer
+	items := s.list(Query{}).Items
+	employee.SortByID(items)
+	for _, e := range items {
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+
+	dir := filepath.Dir(r.path)
+	tmp, err := os.CreateTemp(dir, filepath.Base(r.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	// CreateTemp crea el archivo con permisos 0600; se conservan los del
+	// archivo que reemplaza
+	perm := fs.FileMode(0o644)
+	if info, err := os.Stat(r.path); err == nil {
+		perm = info.Mode().Perm()
+	}
+	err = tmp.Chmod(perm)
+	if err == nil {
+		_, err = tmp.Write(buf.Bytes())
+	}
+	if err == nil {
+		// Sync antes de renombrar: si no, el rename puede llegar al disco
+		// antes que los datos
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), r.path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	// Tras el rename el estado nuevo ya está en el archivo; un fallo al
+	// sincronizar el directorio no debe deshacerlo en memoria
+	syncDir(dir)
+	return nil
+}
+
+// syncDir intenta guardar en disco la entrada del directorio creada por el
+// rename. Algunos sistemas no permiten Sync sobre directorios; como el
+// rename ya es atómico, lo peor que puede pasar tras un corte de luz es
+// perder el último cambio, así que el error se ignora.
+func syncDir(dir string) {
+	d, err := os.Open(dir)
+	if err != nil {
+		return
+	}
+	d.Sync()
+	d.Close()
+}
+
+func (r *FileRepository) Create(firstName, lastName string) (*employee.Employee, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var e *employee.Employee
+	err := r.apply(func(s *state) (err error) {
+		e, err = s.create(firstName, lastName)
+		return err
+	})
+	return e, err
+}
+
+func (r *FileRepository) Get(id int) (*employee.Employee, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.s.get(id)
+}
+
+func (r *FileRepository) Update(id int, firstName, lastName string) (*employee.Employee, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var e *employee.Employee
+	err := r.apply(func(s *state) (err error) {
+		e, err = s.update(id, firstName, lastName)
+		return err
+	})
+	return e, err
+}
+
+func (r *FileRepository) Delete(id int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.apply(func(s *state) error { return s.delete(id) })
+}
+
+func (r *FileRepository) List(q Query) (Page, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.s.list(q), nil
+}
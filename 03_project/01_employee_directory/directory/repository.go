@@ -0,0 +1,169 @@
+// Package directory es el directorio de empleados del proyecto: un
+// Repository para guardarlos, en memoria o en un archivo JSON lines, y un
+// handler HTTP con la API REST.
+package directory
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/FepDev25/gobootcamp/02_basics/04_naming_conventions/employee"
+)
+
+// ErrNotFound se devuelve cuando no existe un empleado con el ID pedido.
+var ErrNotFound = errors.New("directory: empleado no encontrado")
+
+// Repository guarda los empleados. Los *employee.Employee que devuelve son
+// copias: modificarlos no cambia lo guardado.
+type Repository interface {
+	// Create asigna el siguiente ID libre.
+	Create(firstName, lastName string) (*employee.Employee, error)
+	Get(id int) (*employee.Employee, error)
+	Update(id int, firstName, lastName string) (*employee.Employee, error)
+	Delete(id int) error
+	// List devuelve los empleados ordenados por apellido y nombre.
+	List(q Query) (Page, error)
+}
+
+// Query filtra y pagina List.
+type Query struct {
+	Prefix string // Prefijo del nombre, el apellido o el nombre completo, sin distinguir mayúsculas
+	Offset int
+	Limit  int // 0 sin límite
+}
+
+// Page es una página de resultados.
+type Page struct {
+	Items []*employee.Employee
+	Total int // Empleados que cumplen el filtro, en todas las páginas
+}
+
+// state son los datos de un repositorio, sin sincronizar.
+type state struct {
+	employees map[int]employee.Employee
+	nextID    int
+}
+
+func newState() *state {
+	return &state{employees: make(map[int]employee.Employee), nextID: 1}
+}
+
+func (s *state) clone() *state {
+	c := &state{employees: make(map[int]employee.Employee, len(s.employees)), nextID: s.nextID}
+	for id, e := range s.employees {
+		c.employees[id] = e
+	}
+	return c
+}
+
+func (s *state) create(firstName, lastName string) (*employee.Employee, error) {
+	e, err := employee.New(s.nextID, firstName, lastName)
+	if err != nil {
+		return nil, err
+	}
+	s.employees[e.ID()] = *e
+	s.nextID++
+	return e, nil
+}
+
+func (s *state) get(id int) (*employee.Employee, error) {
+	e, ok := s.employees[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrNotFound, id)
+	}
+	return &e, nil
+}
+
+func (s *state) update(id int, firstName, lastName string) (*employee.Employee, error) {
+	e, err := s.get(id)
+	if err != nil {
+		return nil, err
+	}
+	// Se valida con New para no dejar el empleado a medio modificar
+	updated, err := employee.New(e.ID(), firstName, lastName)
+	if err != nil {
+		return nil, err
+	}
+	s.employees[id] = *updated
+	return updated, nil
+}
+
+func (s *state) delete(id int) error {
+	if _, ok := s.employees[id]; !ok {
+		return fmt.Errorf("%w: %d", ErrNotFound, id)
+	}
+	delete(s.employees, id)
+	return nil
+}
+
+func (s *state) list(q Query) Page {
+	prefix := strings.ToLower(strings.TrimSpace(q.Prefix))
+	var items []*employee.Employee
+	for _, e := range s.employees {
+		if prefix == "" || matches(&e, prefix) {
+			items = append(items, &e)
+		}
+	}
+	employee.SortByName(items)
+
+	page := Page{Total: len(items)}
+	start := min(max(q.Offset, 0), len(items))
+	end := len(items)
+	if q.Limit > 0 {
+		end = min(start+q.Limit, end)
+	}
+	page.Items = items[start:end]
+	return page
+}
+
+func matches(e *employee.Employee, prefix string) bool {
+	for _, name := range []string{e.FirstName(), e.LastName(), e.FullName()} {
+		if strings.HasPrefix(strings.ToLower(name), prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// MemoryRepository guarda los empleados en memoria.
+type MemoryRepository struct {
+	mu sync.RWMutex
+	s  *state
+}
+
+// NewMemoryRepository crea un repositorio vacío.
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{s: newState()}
+}
+
+func (r *MemoryRepository) Create(firstName, lastName string) (*employee.Employee, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.s.create(firstName, lastName)
+}
+
+func (r *MemoryRepository) Get(id int) (*employee.Employee, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.s.get(id)
+}
+
+func (r *MemoryRepository) Update(id int, firstName, lastName string) (*employee.Employee, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.s.update(id, firstName, lastName)
+}
+
+func (r *MemoryRepository) Delete(id int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.s.delete(id)
+}
+
+func (r *MemoryRepository) List(q Query) (Page, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.s.list(q), nil
+}
@@ -0,0 +1,246 @@
+package directory
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/FepDev25/gobootcamp/02_basics/04_naming_conventions/employee"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+
+	maxBodySize = 1 << 20
+)
+
+// NewHandler devuelve la API REST sobre repo:
+//
+//	GET    /employees?q=prefijo&page=1&page_size=20
+//	POST   /employees
+//	GET    /employees/{id}
+//	PUT    /employees/{id}
+//	DELETE /employees/{id}
+//
+// Los errores se responden como application/problem+json.
+func NewHandler(repo Repository) http.Handler {
+	s := &server{repo: repo}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /employees", s.list)
+	mux.HandleFunc("POST /employees", s.create)
+	mux.HandleFunc("GET /employees/{id}", s.get)
+	mux.HandleFunc("PUT /employees/{id}", s.update)
+	mux.HandleFunc("DELETE /employees/{id}", s.delete)
+	// Sin estos, ServeMux responde los 405 en texto plano
+	mux.Handle("/employees", methodNotAllowed("GET, HEAD, POST"))
+	mux.Handle("/employees/{id}", methodNotAllowed("GET, HEAD, PUT, DELETE"))
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeProblem(w, r, newProblem(http.StatusNotFound, "no existe la ruta "+r.URL.Path))
+	})
+	return mux
+}
+
+func methodNotAllowed(allow string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Allow", allow)
+		writeProblem(w, r, newProblem(http.StatusMethodNotAllowed, r.Method+" no se admite en "+r.URL.Path))
+	}
+}
+
+type server struct {
+	repo Repository
+}
+
+// employeeInput es el cuerpo de POST y PUT.
+type employeeInput struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+}
+
+// listResponse es una página de GET /employees.
+type listResponse struct {
+	Items    []*employee.Employee `json:"items"`
+	Page     int                  `json:"page"`
+	PageSize int                  `json:"page_size"`
+	Total    int                  `json:"total"`
+}
+
+func (s *server) list(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	var invalid []InvalidParam
+	page, ok := intParam(query.Get("page"), 1, 1, 0)
+	if !ok {
+		invalid = append(invalid, InvalidParam{Name: "page", Reason: "debe ser un entero mayor que 0"})
+	}
+	size, ok := intParam(query.Get("page_size"), DefaultPageSize, 1, MaxPageSize)
+	if !ok {
+		invalid = append(invalid, InvalidParam{Name: "page_size", Reason: fmt.Sprintf("debe ser un entero entre 1 y %d", MaxPageSize)})
+	}
+	if len(invalid) > 0 {
+		p := newProblem(http.StatusBadRequest, "parámetros de paginación inválidos")
+		p.InvalidParams = invalid
+		writeProblem(w, r, p)
+		return
+	}
+
+	result, err := s.repo.List(Query{Prefix: query.Get("q"), Offset: (page - 1) * size, Limit: size})
+	if err != nil {
+		s.fail(w, r, err)
+		return
+	}
+	items := result.Items
+	if items == nil {
+		items = []*employee.Employee{} // "items": [] en vez de null
+	}
+	writeJSON(w, http.StatusOK, listResponse{Items: items, Page: page, PageSize: size, Total: result.Total})
+}
+
+// intParam convierte value, o devuelve def si está vacío. hi 0 es sin
+// máximo.
+func intParam(value string, def, lo, hi int) (int, bool) {
+	if value == "" {
+		return def, true
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < lo || (hi > 0 && n > hi) {
+		return 0, false
+	}
+	return n, true
+}
+
+func (s *server) create(w http.ResponseWriter, r *http.Request) {
+	in, ok := decode(w, r)
+	if !ok {
+		return
+	}
+	e, err := s.repo.Create(in.FirstName, in.LastName)
+	if err != nil {
+		s.fail(w, r, err)
+		return
+	}
+	w.Header().Set("Location", fmt.Sprintf("/employees/%d", e.ID()))
+	writeJSON(w, http.StatusCreated, e)
+}
+
+func (s *server) get(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	e, err := s.repo.Get(id)
+	if err != nil {
+		s.fail(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, e)
+}
+
+func (s *server) update(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	in, ok := decode(w, r)
+	if !ok {
+		return
+	}
+	e, err := s.repo.Update(id, in.FirstName, in.LastName)
+	if err != nil {
+		s.fail(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, e)
+}
+
+func (s *server) delete(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	if err := s.repo.Delete(id); err != nil {
+		s.fail(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func pathID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil || id <= 0 {
+		// Un ID que no puede existir es lo mismo que uno que no existe
+		writeProblem(w, r, newProblem(http.StatusNotFound, fmt.Sprintf("no existe el empleado %q", r.PathValue("id"))))
+		return 0, false
+	}
+	return id, true
+}
+
+// decode lee y valida el cuerpo JSON. Los campos desconocidos se rechazan para que
+// un error de tipeo ("firstname") no pase como un nombre vacío.
+func decode(w http.ResponseWriter, r *http.Request) (employeeInput, bool) {
+	var in employeeInput
+	if ct := r.Header.Get("Content-Type"); ct != "" && !strings.HasPrefix(ct, "application/json") {
+		writeProblem(w, r, newProblem(http.StatusUnsupportedMediaType, "el cuerpo debe ser application/json"))
+		return in, false
+	}
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize))
+	dec.DisallowUnknownFields()
+	err := dec.Decode(&in)
+	if err == nil && dec.Decode(&struct{}{}) != io.EOF {
+		err = errors.New("hay datos después del objeto JSON")
+	}
+	if err != nil {
+		status := http.StatusBadRequest
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		writeProblem(w, r, newProblem(status, "cuerpo inválido: "+err.Error()))
+		return in, false
+	}
+	return in, validate(w, r, in)
+}
+
+// fail traduce los errores del repositorio.
+func (s *server) fail(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		writeProblem(w, r, newProblem(http.StatusNotFound, err.Error()))
+	case errors.Is(err, employee.ErrInvalidName), errors.Is(err, employee.ErrInvalidID):
+		writeProblem(w, r, newProblem(http.StatusUnprocessableEntity, err.Error()))
+	default:
+		// El detalle de un error interno no se muestra al cliente
+		writeProblem(w, r, newProblem(http.StatusInternalServerError, ""))
+	}
+}
+
+// validate revisa cada campo por separado para informar todos los errores
+// a la vez. Los setters validan igual que employee.New y un Employee de
+// prueba no afecta a nada.
+func validate(w http.ResponseWriter, r *http.Request, in employeeInput) bool {
+	var probe employee.Employee
+	var invalid []InvalidParam
+	if err := probe.SetFirstName(in.FirstName); err != nil {
+		invalid = append(invalid, InvalidParam{Name: "first_name", Reason: err.Error()})
+	}
+	if err := probe.SetLastName(in.LastName); err != nil {
+		invalid = append(invalid, InvalidParam{Name: "last_name", Reason: err.Error()})
+	}
+	if len(invalid) == 0 {
+		return true
+	}
+	p := newProblem(http.StatusUnprocessableEntity, "el empleado no es válido")
+	p.InvalidParams = invalid
+	writeProblem(w, r, p)
+	return false
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,90 @@
+// Directorio de empleados: la API REST del proyecto de la segunda mitad del
+// curso, sobre el tipo Employee de 04_naming_conventions.
+//
+// Ej: go run ./03_project/01_employee_directory -data empleados.jsonl
+//
+//	curl -H 'Content-Type: application/json' -d '{"first_name":"Felipe","last_name":"Peralta"}' localhost:8080/employees
+//	curl 'localhost:8080/employees?q=per&page=1&page_size=10'
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/FepDev25/gobootcamp/03_project/01_employee_directory/directory"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:8080", "dirección en la que escuchar")
+	data := flag.String("data", "", "archivo JSON lines donde guardar los empleados; vacío los guarda solo en memoria")
+	flag.Parse()
+
+	if err := run(*addr, *data); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+func run(addr, data string) error {
+	var repo directory.Repository = directory.NewMemoryRepository()
+	if data != "" {
+		file, err := directory.OpenFile(data)
+		if err != nil {
+			return err
+		}
+		repo = file
+	}
+
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           logRequests(directory.NewHandler(repo)),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	// Ctrl+C espera a que terminen las peticiones en curso
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	errc := make(chan error, 1)
+	go func() { errc <- srv.ListenAndServe() }()
+	log.Printf("Escuchando en http://%s", addr)
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+	log.Print("Cerrando...")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// statusRecorder guarda el código de estado para el log.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		log.Printf("%s %s %d %s", r.Method, r.URL.RequestURI(), rec.status, time.Since(start).Round(time.Microsecond))
+	})
+}
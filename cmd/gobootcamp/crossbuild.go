@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/FepDev25/gobootcamp/internal/crossbuild"
+	"github.com/FepDev25/gobootcamp/internal/lessons"
+)
+
+func runCrossbuild(args []string) error {
+	fs := flag.NewFlagSet("crossbuild", flag.ExitOnError)
+	root := fs.String("root", ".", "raíz del repositorio")
+	targets := fs.String("targets", "linux/amd64,windows/amd64,darwin/arm64", "plataformas GOOS/GOARCH separadas por comas")
+	format := fs.String("format", "md", "formato del reporte: md o csv")
+	output := fs.String("o", "", "archivo de salida (por defecto stdout)")
+	top := fs.Int("top", 5, "paquetes más pesados a listar por lección (0 para omitir)")
+	fs.Parse(args)
+
+	list, err := crossbuild.ParseTargets(*targets)
+	if err != nil {
+		return err
+	}
+	found, err := lessons.Find(*root)
+	if err != nil {
+		return err
+	}
+
+	var write func(*crossbuild.Report, io.Writer) error
+	switch *format {
+	case "md":
+		write = (*crossbuild.Report).WriteMarkdown
+	case "csv":
+		write = (*crossbuild.Report).WriteCSV
+	default:
+		return fmt.Errorf("formato desconocido %q", *format)
+	}
+
+	report, err := crossbuild.Run(context.Background(), found, crossbuild.Options{Targets: list, Top: *top})
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		return write(report, os.Stdout)
+	}
+	f, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	if err := write(report, f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/FepDev25/gobootcamp/internal/globals"
+	"github.com/FepDev25/gobootcamp/internal/loader"
+)
+
+func runGlobals(args []string) error {
+	fs := flag.NewFlagSet("globals", flag.ExitOnError)
+	all := fs.Bool("all", false, "lista también las globales de solo lectura")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Uso: gobootcamp globals [opciones] [directorio | directorio/...]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	targets := fs.Args()
+	if len(targets) == 0 {
+		targets = []string{"."}
+	}
+	var dirs []string
+	for _, t := range targets {
+		found, err := goDirs(t)
+		if err != nil {
+			return err
+		}
+		dirs = append(dirs, found...)
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	var reports []*globals.Report
+	concurrent := 0
+	for _, dir := range dirs {
+		pkg, err := loader.LoadDir(dir)
+		if err != nil {
+			return err
+		}
+		r := globals.Check(pkg, cwd)
+		r.Dir = dir
+		if len(r.Vars) == 0 || len(r.Mutable()) == 0 && !*all {
+			continue
+		}
+		for _, v := range r.Vars {
+			if v.Concurrent() {
+				concurrent++
+			}
+		}
+		reports = append(reports, r)
+	}
+
+	if len(reports) == 0 {
+		fmt.Println("No hay estado global mutable.")
+		return nil
+	}
+	if err := globals.WriteText(os.Stdout, reports, *all); err != nil {
+		return err
+	}
+	if concurrent > 0 {
+		return fmt.Errorf("%d globales se modifican desde goroutines", concurrent)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/FepDev25/gobootcamp/internal/imports"
+	"github.com/FepDev25/gobootcamp/internal/lessons"
+)
+
+func runImports(args []string) error {
+	fs := flag.NewFlagSet("imports", flag.ExitOnError)
+	root := fs.String("root", ".", "raíz del repositorio")
+	format := fs.String("format", "text", "formato de salida: text, dot o svg (requiere Graphviz)")
+	depth := fs.Int("depth", 1, "niveles de dependencias a mostrar; 1 muestra solo las directas")
+	output := fs.String("o", "", "archivo de salida (por defecto stdout)")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Uso: gobootcamp imports [opciones] [lección...]")
+		fmt.Fprintln(os.Stderr, "\nSin lecciones analiza todas. Ej: gobootcamp imports 02_basics/01_imports")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	found, err := lessons.Find(*root)
+	if err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		found, err = filterLessons(found, fs.Args())
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	var graphs []*imports.Graph
+	for _, l := range found {
+		g, err := imports.Analyze(ctx, l)
+		if err != nil {
+			return err
+		}
+		graphs = append(graphs, g)
+	}
+
+	var buf bytes.Buffer
+	switch *format {
+	case "text":
+		err = imports.WriteText(&buf, graphs, *depth)
+	case "dot":
+		err = imports.WriteDOT(&buf, graphs, *depth)
+	case "svg":
+		err = writeSVG(ctx, &buf, graphs, *depth)
+	default:
+		return fmt.Errorf("formato desconocido %q", *format)
+	}
+	if err != nil {
+		return err
+	}
+
+	w := io.Writer(os.Stdout)
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	_, err = buf.WriteTo(w)
+	return err
+}
+
+// writeSVG pasa el grafo DOT por el comando dot de Graphviz.
+func writeSVG(ctx context.Context, w io.Writer, graphs []*imports.Graph, depth int) error {
+	dot, err := exec.LookPath("dot")
+	if err != nil {
+		return errors.New("no se encontró el comando dot de Graphviz; usa -format dot y conviértelo aparte")
+	}
+	var in bytes.Buffer
+	if err := imports.WriteDOT(&in, graphs, depth); err != nil {
+		return err
+	}
+	cmd := exec.CommandContext(ctx, dot, "-Tsvg")
+	cmd.Stdin = &in
+	cmd.Stdout = w
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// filterLessons devuelve las lecciones cuyo nombre coincide o empieza con
+// alguno de los indicados, ej. 02_basics incluye todas sus lecciones.
+func filterLessons(found []lessons.Lesson, names []string) ([]lessons.Lesson, error) {
+	var out []lessons.Lesson
+	for _, name := range names {
+		name = strings.TrimSuffix(strings.TrimPrefix(name, "./"), "/")
+		n := len(out)
+		for _, l := range found {
+			if l.Name == name || strings.HasPrefix(l.Name, name+"/") {
+				out = append(out, l)
+			}
+		}
+		if len(out) == n {
+			return nil, fmt.Errorf("no hay lecciones en %q", name)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,61 @@
+// gobootcamp reúne las herramientas del bootcamp en un solo comando.
+//
+// Uso:
+//
+//	go run ./cmd/gobootcamp <comando> [opciones]
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) error
+}
+
+var commands = []command{
+	{"crossbuild", "compila las lecciones para varias plataformas y reporta tamaños", runCrossbuild},
+	{"keygen", "genera la llave ed25519 con la que se firman las entregas", runKeygen},
+	{"submit", "empaqueta y firma los ejercicios del estudiante", runSubmit},
+	{"verify", "verifica la firma, los hashes y las pruebas de una entrega", runVerify},
+	{"review", "genera un reporte de revisión de código en Markdown", runReview},
+	{"search", "busca en la teoría y el código de las lecciones", runSearch},
+	{"record", "graba una sesión de una lección en formato asciicast v2", runRecord},
+	{"replay", "reproduce una sesión grabada", runReplay},
+	{"imports", "muestra el grafo de importaciones de cada lección", runImports},
+	{"naming", "revisa y corrige los nombres según las convenciones de Go", runNaming},
+	{"scopes", "muestra el árbol de ámbitos y las declaraciones que se ocultan", runScopes},
+	{"globals", "busca variables globales que se modifican y quién las modifica", runGlobals},
+}
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] == "-h" || os.Args[1] == "help" {
+		usage()
+		return
+	}
+
+	for _, c := range commands {
+		if c.name == os.Args[1] {
+			if err := c.run(os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, "Error:", err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "Comando desconocido: %s\n\n", os.Args[1])
+	usage()
+	os.Exit(2)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "Uso: gobootcamp <comando> [opciones]")
+	fmt.Fprintln(os.Stderr, "\nComandos:")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-12s %s\n", c.name, c.summary)
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/FepDev25/gobootcamp/internal/loader"
+	"github.com/FepDev25/gobootcamp/internal/naming"
+)
+
+func runNaming(args []string) error {
+	fs := flag.NewFlagSet("naming", flag.ExitOnError)
+	rules := fs.String("rules", "", "reglas separadas por comas (por defecto todas): "+ruleList())
+	config := fs.String("config", "", "archivo JSON con la configuración")
+	allowCaps := fs.Bool("allow-caps-constants", false, "acepta constantes en MAYÚSCULAS, como en la lección")
+	fix := fs.Bool("fix", false, "renombra los identificadores y todos sus usos")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Uso: gobootcamp naming [opciones] [directorio | directorio/...]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	cfg := naming.DefaultConfig()
+	if *config != "" {
+		var err error
+		if cfg, err = naming.LoadConfig(*config); err != nil {
+			return err
+		}
+	}
+	if *rules != "" {
+		list, err := naming.ParseRules(*rules)
+		if err != nil {
+			return err
+		}
+		cfg.Rules = list
+	}
+	cfg.AllowCapsConstants = cfg.AllowCapsConstants || *allowCaps
+
+	targets := fs.Args()
+	if len(targets) == 0 {
+		targets = []string{"."}
+	}
+	var dirs []string
+	for _, t := range targets {
+		found, err := goDirs(t)
+		if err != nil {
+			return err
+		}
+		dirs = append(dirs, found...)
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	remaining := 0
+	for _, dir := range dirs {
+		pkg, err := loader.LoadDirTests(dir)
+		if err != nil {
+			return err
+		}
+		findings := naming.Check(pkg, cwd, cfg)
+		if !*fix {
+			for _, f := range findings {
+				printFinding(f)
+			}
+			remaining += len(findings)
+			continue
+		}
+
+		files, renamed, skipped, err := naming.Fix(pkg, findings)
+		if err != nil {
+			return err
+		}
+		if err := naming.WriteFiles(files); err != nil {
+			return err
+		}
+		for _, r := range renamed {
+			fmt.Printf("%s: %s → %s (%d usos)\n", r.Pos, r.Name, r.Suggested, r.Uses)
+		}
+		for _, s := range skipped {
+			fmt.Printf("%s: %s sin cambiar: %s\n", s.Pos, s.Name, s.Reason)
+		}
+		for _, f := range findings {
+			if f.Suggested == "" {
+				printFinding(f)
+			}
+		}
+		remaining += len(findings) - len(renamed)
+	}
+
+	if remaining > 0 {
+		return fmt.Errorf("%d identificadores no siguen las convenciones", remaining)
+	}
+	return nil
+}
+
+func printFinding(f naming.Finding) {
+	line := fmt.Sprintf("%s: [%s] %s", f.Pos, f.Rule, f.Message)
+	if f.Suggested != "" && f.Rule != naming.Initialisms {
+		line += fmt.Sprintf("; sugerencia: %s", f.Suggested)
+	}
+	fmt.Println(line)
+}
+
+func ruleList() string {
+	names := make([]string, len(naming.Rules))
+	for i, r := range naming.Rules {
+		names[i] = string(r)
+	}
+	return strings.Join(names, ", ")
+}
+
+// goDirs devuelve dir si tiene archivos .go o, si termina en /..., todos los
+// directorios con archivos .go debajo de él, como go list.
+func goDirs(target string) ([]string, error) {
+	root, recursive := strings.CutSuffix(target, "...")
+	root = filepath.Clean(root)
+	if !recursive {
+		if !hasGoFiles(root) {
+			return nil, fmt.Errorf("%s: no hay archivos .go", root)
+		}
+		return []string{root}, nil
+	}
+
+	var dirs []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		name := d.Name()
+		if path != root && (strings.HasPrefix(name, ".") || name == "testdata" || name == "vendor") {
+			return filepath.SkipDir
+		}
+		if hasGoFiles(path) {
+			dirs = append(dirs, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(dirs) == 0 {
+		return nil, errors.New(target + ": no hay paquetes")
+	}
+	sort.Strings(dirs)
+	return dirs, nil
+}
+
+func hasGoFiles(dir string) bool {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".go") && !strings.HasSuffix(e.Name(), "_test.go") {
+			return true
+		}
+	}
+	return false
+}
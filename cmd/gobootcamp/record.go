@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"time"
+
+	"github.com/FepDev25/gobootcamp/internal/record"
+)
+
+func runRecord(args []string) error {
+	fs := flag.NewFlagSet("record", flag.ExitOnError)
+	output := fs.String("o", "", "archivo de salida (por defecto sesion-<fecha>.cast)")
+	title := fs.String("title", "", "título de la sesión")
+	width := fs.Int("cols", 80, "ancho de la terminal")
+	height := fs.Int("rows", 24, "alto de la terminal")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Uso: gobootcamp record [opciones] -- <comando> [argumentos]")
+		fmt.Fprintln(os.Stderr, "Ej:  gobootcamp record -o juego.cast -- go run ./02_basics/07_loops")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errors.New("indica el comando a grabar")
+	}
+	if *output == "" {
+		*output = "sesion-" + time.Now().Format("20060102-150405") + ".cast"
+	}
+
+	session := record.Session{
+		Stdin:  os.Stdin,
+		Stdout: os.Stdout,
+		Stderr: os.Stderr,
+		Width:  *width,
+		Height: *height,
+		Title:  *title,
+	}
+	cast, err := session.Record(exec.Command(fs.Arg(0), fs.Args()[1:]...))
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	if err := cast.Encode(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "\nSesión guardada en %s (código de salida %d)\n", *output, *cast.Header.ExitCode)
+	return nil
+}
+
+func runReplay(args []string) error {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	speed := fs.Float64("speed", 1, "velocidad de reproducción (2 = el doble de rápido)")
+	idle := fs.Duration("idle", 2*time.Second, "pausa máxima entre eventos (0 sin límite)")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Uso: gobootcamp replay [opciones] <sesion.cast>")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errors.New("indica el archivo de la sesión")
+	}
+	f, err := os.Open(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	cast, err := record.Read(f)
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if err := (record.Player{Speed: *speed, MaxIdle: *idle}).Play(ctx, cast, os.Stdout); err != nil {
+		return err
+	}
+	if cast.Header.ExitCode != nil {
+		fmt.Fprintf(os.Stderr, "\n[fin de la sesión, código de salida %d]\n", *cast.Header.ExitCode)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/FepDev25/gobootcamp/internal/review"
+	"github.com/FepDev25/gobootcamp/internal/submit"
+)
+
+func runReview(args []string) error {
+	fs := flag.NewFlagSet("review", flag.ExitOnError)
+	output := fs.String("o", "", "archivo de salida (por defecto stdout)")
+	maxComplexity := fs.Int("max-complexity", 10, "complejidad ciclomática máxima por función")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Uso: gobootcamp review [opciones] <directorio | entrega.tar.gz>")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errors.New("indica un directorio o una entrega")
+	}
+	target := fs.Arg(0)
+	ctx := context.Background()
+
+	root := target
+	if strings.HasSuffix(target, ".tar.gz") {
+		// Las entregas se revisan solo si la firma y los hashes son válidos
+		tmp, err := os.MkdirTemp("", "gobootcamp-review-")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(tmp)
+
+		f, err := os.Open(target)
+		if err != nil {
+			return err
+		}
+		v, err := submit.Verify(ctx, f, submit.VerifyOptions{Dir: tmp})
+		f.Close()
+		if err != nil {
+			return err
+		}
+		if !v.OK() {
+			return errors.New("la entrega no pasó la verificación; revísala con gobootcamp verify")
+		}
+		root = tmp
+	}
+
+	report, err := review.Run(ctx, root, review.Options{MaxComplexity: *maxComplexity})
+	if err != nil {
+		return err
+	}
+
+	w := io.Writer(os.Stdout)
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	return report.WriteMarkdown(w, filepath.Base(target))
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/FepDev25/gobootcamp/internal/loader"
+	"github.com/FepDev25/gobootcamp/internal/scopes"
+)
+
+func runScopes(args []string) error {
+	fs := flag.NewFlagSet("scopes", flag.ExitOnError)
+	shadowOnly := fs.Bool("shadow", false, "muestra solo las declaraciones que ocultan a otra")
+	universe := fs.Bool("universe", false, "lista también los identificadores predeclarados")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Uso: gobootcamp scopes [opciones] <archivo.go | directorio>")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errors.New("indica un archivo o un directorio")
+	}
+	target := fs.Arg(0)
+	info, err := os.Stat(target)
+	if err != nil {
+		return err
+	}
+
+	// Un archivo se analiza junto con el resto de su paquete, para resolver
+	// lo que declaran los demás archivos
+	dir, file := target, ""
+	if !info.IsDir() {
+		dir, file = filepath.Dir(target), filepath.Base(target)
+	}
+	pkg, err := loader.LoadDir(dir)
+	if err != nil {
+		return err
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	tree := scopes.Build(pkg, cwd, file)
+	shadows := tree.Shadows()
+	if !*shadowOnly {
+		if err := tree.WriteTree(os.Stdout, *universe); err != nil {
+			return err
+		}
+		if len(shadows) > 0 {
+			fmt.Println()
+		}
+	}
+	return scopes.WriteShadows(os.Stdout, shadows)
+}
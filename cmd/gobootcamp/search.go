@@ -0,0 +1,52 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/FepDev25/gobootcamp/internal/search"
+)
+
+func runSearch(args []string) error {
+	fs := flag.NewFlagSet("search", flag.ExitOnError)
+	root := fs.String("root", ".", "raíz del repositorio")
+	limit := fs.Int("n", 10, "cantidad máxima de resultados")
+	context := fs.Int("C", 1, "líneas de contexto antes y después de cada resultado")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Uso: gobootcamp search [opciones] <consulta>")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	query := strings.Join(fs.Args(), " ")
+	if query == "" {
+		fs.Usage()
+		return errors.New("indica qué buscar")
+	}
+
+	idx, err := search.Build(*root)
+	if err != nil {
+		return err
+	}
+	hits := idx.Search(query, *limit, *context)
+	if len(hits) == 0 {
+		fmt.Println("Sin resultados para:", query)
+		return nil
+	}
+
+	for _, h := range hits {
+		fmt.Printf("%s:%d  [%s, %.2f]\n", h.File, h.Line, h.Kind, h.Score)
+		for i, text := range h.Snippet {
+			marker := " "
+			if h.First+i == h.Line {
+				marker = ">"
+			}
+			fmt.Printf("  %s %4d | %s\n", marker, h.First+i, text)
+		}
+		fmt.Println()
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/FepDev25/gobootcamp/internal/submit"
+)
+
+func runKeygen(args []string) error {
+	fs := flag.NewFlagSet("keygen", flag.ExitOnError)
+	defaultPath, _ := submit.DefaultKeyPath()
+	output := fs.String("o", defaultPath, "ruta de la llave privada (la pública se guarda en <ruta>.pub)")
+	fs.Parse(args)
+
+	pub, err := submit.GenerateKey(*output)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Llave privada:", *output)
+	fmt.Println("Llave pública:", *output+".pub")
+	fmt.Println("Huella:", submit.Fingerprint(pub))
+	return nil
+}
+
+func runSubmit(args []string) error {
+	fs := flag.NewFlagSet("submit", flag.ExitOnError)
+	defaultPath, _ := submit.DefaultKeyPath()
+	root := fs.String("root", ".", "raíz del repositorio")
+	keyPath := fs.String("key", defaultPath, "llave privada generada con gobootcamp keygen")
+	output := fs.String("o", "", "archivo de salida (por defecto entrega-<fecha>.tar.gz)")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Uso: gobootcamp submit [opciones] <directorio>...")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errors.New("indica al menos un directorio de ejercicios")
+	}
+	key, err := submit.LoadPrivateKey(*keyPath)
+	if err != nil {
+		return fmt.Errorf("%w (genera una con: gobootcamp keygen)", err)
+	}
+	if *output == "" {
+		*output = "entrega-" + time.Now().Format("20060102-150405") + ".tar.gz"
+	}
+
+	f, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	m, err := submit.Pack(context.Background(), f, submit.Options{Root: *root, Dirs: fs.Args(), Key: key})
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(*output)
+		return err
+	}
+
+	passed := 0
+	for _, t := range m.Tests {
+		if t.Passed {
+			passed++
+		}
+	}
+	fmt.Printf("Entrega creada: %s\n", *output)
+	fmt.Printf("Archivos: %d, pruebas: %d/%d directorios pasan, %s\n", len(m.Files), passed, len(m.Tests), m.GoVersion)
+	fmt.Println("Huella de la llave:", submit.Fingerprint(key.Public().(ed25519.PublicKey)))
+	return nil
+}
+
+func runVerify(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	pubPath := fs.String("pubkey", "", "llave pública esperada del estudiante (opcional)")
+	noTests := fs.Bool("no-tests", false, "no volver a ejecutar las pruebas")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Uso: gobootcamp verify [opciones] <entrega.tar.gz>")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errors.New("indica un archivo de entrega")
+	}
+	opts := submit.VerifyOptions{RunTests: !*noTests}
+	if *pubPath != "" {
+		pub, err := submit.LoadPublicKey(*pubPath)
+		if err != nil {
+			return err
+		}
+		opts.TrustedKey = pub
+	}
+
+	f, err := os.Open(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	v, err := submit.Verify(context.Background(), f, opts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Firma válida:", v.Fingerprint)
+	fmt.Printf("Creada: %s con %s\n", v.Manifest.CreatedAt.Format(time.RFC3339), v.Manifest.GoVersion)
+	for _, p := range v.Mismatched {
+		fmt.Println("  hash distinto:", p)
+	}
+	for _, p := range v.Missing {
+		fmt.Println("  falta:", p)
+	}
+	for _, p := range v.Extra {
+		fmt.Println("  no declarado:", p)
+	}
+	for _, t := range v.Tests {
+		status := "ok"
+		if !t.Passed {
+			status = "FALLA"
+		}
+		fmt.Printf("  %-6s %s\n", status, t.Dir)
+	}
+	if !v.OK() {
+		return errors.New("la entrega no pasó la verificación")
+	}
+	fmt.Println("Entrega verificada.")
+	return nil
+}
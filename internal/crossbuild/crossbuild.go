@@ -0,0 +1,201 @@
+// Package crossbuild compila las lecciones para varias plataformas y mide
+// el tamaño de los binarios resultantes.
+package crossbuild
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/FepDev25/gobootcamp/internal/lessons"
+)
+
+// Target es un par GOOS/GOARCH.
+type Target struct {
+	GOOS   string
+	GOARCH string
+}
+
+func (t Target) String() string { return t.GOOS + "/" + t.GOARCH }
+
+// ParseTargets interpreta una lista separada por comas, ej. "linux/amd64,windows/arm64".
+func ParseTargets(s string) ([]Target, error) {
+	var targets []Target
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		goos, goarch, ok := strings.Cut(part, "/")
+		if !ok || goos == "" || goarch == "" {
+			return nil, fmt.Errorf("plataforma inválida %q, se esperaba GOOS/GOARCH", part)
+		}
+		targets = append(targets, Target{GOOS: goos, GOARCH: goarch})
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("no se indicó ninguna plataforma")
+	}
+	return targets, nil
+}
+
+// Result guarda los tamaños de una lección compilada para una plataforma.
+type Result struct {
+	Lesson   string
+	Target   Target
+	Size     int64         // Binario normal
+	Stripped int64         // Binario con -ldflags="-s -w"
+	Packages []PackageSize // Paquetes más pesados, si se pidió Options.Top
+	Err      error         // Fallo al compilar; Size y Stripped no son válidos
+
+	// PackagesErr es un fallo al leer la tabla de símbolos. Los tamaños
+	// siguen siendo válidos; solo falta Packages.
+	PackagesErr error
+}
+
+// PackageSize es el aporte de un paquete al binario de una lección.
+type PackageSize struct {
+	Path string
+	Size int64
+}
+
+// Report es el resultado completo de la matriz de compilación.
+type Report struct {
+	Targets []Target
+	Results []Result
+}
+
+// Options configura Run.
+type Options struct {
+	Targets []Target
+	Top     int // Paquetes a listar por lección y plataforma; 0 desactiva el análisis
+}
+
+// Run compila cada lección para cada plataforma. Los fallos de compilación
+// y del análisis por paquete quedan registrados en el Result
+// correspondiente, en Err y PackagesErr, y no detienen la matriz.
+func Run(ctx context.Context, list []lessons.Lesson, opts Options) (*Report, error) {
+	tmp, err := os.MkdirTemp("", "gobootcamp-crossbuild-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	report := &Report{Targets: opts.Targets}
+	for _, l := range list {
+		for i, t := range opts.Targets {
+			res := Result{Lesson: l.Name, Target: t}
+			bin := filepath.Join(tmp, fmt.Sprintf("bin-%d", i))
+
+			res.Size, res.Err = build(ctx, l, t, bin, false)
+			if res.Err == nil {
+				res.Stripped, res.Err = build(ctx, l, t, bin+"-s", true)
+			}
+			if res.Err == nil && opts.Top > 0 {
+				// Cada plataforma enlaza paquetes distintos, ej. syscall
+				pkgs, err := packageSizes(ctx, bin)
+				if err != nil {
+					res.PackagesErr = fmt.Errorf("%s: %w", t, err)
+				}
+				if len(pkgs) > opts.Top {
+					pkgs = pkgs[:opts.Top]
+				}
+				res.Packages = pkgs
+			}
+			report.Results = append(report.Results, res)
+		}
+	}
+	return report, nil
+}
+
+// build compila la lección y devuelve el tamaño del binario.
+func build(ctx context.Context, l lessons.Lesson, t Target, out string, strip bool) (int64, error) {
+	args := []string{"build", "-trimpath", "-o", out}
+	if strip {
+		args = append(args, "-ldflags=-s -w")
+	}
+	args = append(args, l.Files...)
+
+	cmd := exec.CommandContext(ctx, "go", args...)
+	cmd.Dir = l.Dir
+	cmd.Env = append(os.Environ(), "GOOS="+t.GOOS, "GOARCH="+t.GOARCH, "CGO_ENABLED=0")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return 0, fmt.Errorf("%s: %v\n%s", t, err, bytes.TrimSpace(output))
+	}
+
+	info, err := os.Stat(out)
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// packageSizes suma el tamaño de los símbolos del binario agrupados por paquete.
+func packageSizes(ctx context.Context, bin string) ([]PackageSize, error) {
+	output, err := exec.CommandContext(ctx, "go", "tool", "nm", "-size", bin).Output()
+	if err != nil {
+		return nil, fmt.Errorf("go tool nm: %w", err)
+	}
+
+	totals := make(map[string]int64)
+	sc := bufio.NewScanner(bytes.NewReader(output))
+	for sc.Scan() {
+		// Formato: dirección tamaño tipo nombre
+		fields := strings.Fields(sc.Text())
+		if len(fields) < 4 {
+			continue
+		}
+		// Solo texto, datos y datos de solo lectura ocupan espacio en el archivo
+		switch fields[2] {
+		case "T", "t", "R", "r", "D", "d":
+		default:
+			continue
+		}
+		size, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		totals[symbolPackage(strings.Join(fields[3:], " "))] += size
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	pkgs := make([]PackageSize, 0, len(totals))
+	for path, size := range totals {
+		pkgs = append(pkgs, PackageSize{Path: path, Size: size})
+	}
+	sort.Slice(pkgs, func(i, j int) bool {
+		if pkgs[i].Size != pkgs[j].Size {
+			return pkgs[i].Size > pkgs[j].Size
+		}
+		return pkgs[i].Path < pkgs[j].Path
+	})
+	return pkgs, nil
+}
+
+// symbolPackage extrae la ruta del paquete de un símbolo como
+// "net/http.(*Transport).roundTrip" o "type:*net/url.URL".
+func symbolPackage(sym string) string {
+	sym = strings.TrimPrefix(sym, "type:")
+	sym = strings.TrimLeft(sym, "*[]")
+	if strings.HasPrefix(sym, "go:") || strings.HasPrefix(sym, "go.") {
+		return "(datos del runtime)"
+	}
+	if i := strings.Index(sym, "["); i >= 0 {
+		sym = sym[:i] // Argumentos de tipo de una instancia genérica
+	}
+
+	start := strings.LastIndex(sym, "/") + 1
+	dot := strings.Index(sym[start:], ".")
+	if dot < 0 {
+		return "(otros)"
+	}
+	return sym[:start+dot]
+}
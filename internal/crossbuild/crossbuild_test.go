@@ -0,0 +1,87 @@
+package crossbuild
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseTargets(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []Target
+	}{
+		{"linux/amd64", []Target{{"linux", "amd64"}}},
+		{"linux/amd64,windows/arm64", []Target{{"linux", "amd64"}, {"windows", "arm64"}}},
+		{" darwin/arm64 , js/wasm ,", []Target{{"darwin", "arm64"}, {"js", "wasm"}}},
+	}
+	for _, tt := range tests {
+		got, err := ParseTargets(tt.in)
+		if err != nil {
+			t.Errorf("ParseTargets(%q): %v", tt.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseTargets(%q) = %v, se esperaba %v", tt.in, got, tt.want)
+		}
+	}
+
+	for _, in := range []string{"", " , ", "linux", "linux/", "/amd64", "linux-amd64,windows/amd64"} {
+		if got, err := ParseTargets(in); err == nil {
+			t.Errorf("ParseTargets(%q) = %v, se esperaba un error", in, got)
+		}
+	}
+}
+
+func TestSymbolPackage(t *testing.T) {
+	tests := []struct{ sym, want string }{
+		{"main.main", "main"},
+		{"fmt.Println", "fmt"},
+		{"net/http.(*Transport).roundTrip", "net/http"},
+		{"type:*net/url.URL", "net/url"},
+		{"type:[]encoding/json.Number", "encoding/json"},
+		{"github.com/FepDev25/gobootcamp/internal/lessons.Find", "github.com/FepDev25/gobootcamp/internal/lessons"},
+		{"slices.Sort[go.shape.[]int,go.shape.int]", "slices"},
+		{"sync/atomic.(*Pointer[go.shape.struct {}]).Load", "sync/atomic"},
+		{"go:buildinfo", "(datos del runtime)"},
+		{"go.itab.*os.File,io.Writer", "(datos del runtime)"},
+		{"runtime", "(otros)"},
+	}
+	for _, tt := range tests {
+		if got := symbolPackage(tt.sym); got != tt.want {
+			t.Errorf("symbolPackage(%q) = %q, se esperaba %q", tt.sym, got, tt.want)
+		}
+	}
+}
+
+func TestReportPackagesErr(t *testing.T) {
+	target := Target{"linux", "amd64"}
+	r := &Report{Targets: []Target{target}, Results: []Result{{
+		Lesson:      "01_hello_world",
+		Target:      target,
+		Size:        2 << 20,
+		Stripped:    1 << 20,
+		PackagesErr: errors.New("linux/amd64: go tool nm: falló"),
+	}}}
+
+	var md strings.Builder
+	if err := r.WriteMarkdown(&md); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(md.String(), "| 01_hello_world | linux/amd64 | 2.00 MiB | 1.00 MiB | 50.0% |") {
+		t.Errorf("sin tabla de símbolos se esperaban igual los tamaños:\n%s", md.String())
+	}
+	if !strings.Contains(md.String(), "go tool nm: falló") {
+		t.Errorf("falta el error de la tabla de símbolos:\n%s", md.String())
+	}
+
+	var csv strings.Builder
+	if err := r.WriteCSV(&csv); err != nil {
+		t.Fatal(err)
+	}
+	want := "01_hello_world,linux,amd64,2097152,1048576,,,linux/amd64: go tool nm: falló\n"
+	if _, row, _ := strings.Cut(csv.String(), "\n"); row != want {
+		t.Errorf("fila CSV %q, se esperaba %q", row, want)
+	}
+}
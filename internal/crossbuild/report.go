@@ -0,0 +1,105 @@
+package crossbuild
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WriteMarkdown escribe el reporte como tablas en Markdown.
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+
+	b.WriteString("# Matriz de compilación cruzada\n\n")
+	b.WriteString("| Lección | Plataforma | Tamaño | Con -s -w | Ahorro |\n")
+	b.WriteString("|---|---|---:|---:|---:|\n")
+	for _, res := range r.Results {
+		if res.Err != nil {
+			fmt.Fprintf(&b, "| %s | %s | error | error | - |\n", res.Lesson, res.Target)
+			continue
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %.1f%% |\n",
+			res.Lesson, res.Target, humanSize(res.Size), humanSize(res.Stripped), savings(res))
+	}
+
+	heading := false
+	for _, res := range r.Results {
+		if len(res.Packages) == 0 {
+			continue
+		}
+		if !heading {
+			b.WriteString("\n## Paquetes más pesados\n")
+			heading = true
+		}
+		fmt.Fprintf(&b, "\n### %s (%s)\n\n", res.Lesson, res.Target)
+		b.WriteString("| Paquete | Tamaño |\n|---|---:|\n")
+		for _, p := range res.Packages {
+			fmt.Fprintf(&b, "| %s | %s |\n", p.Path, humanSize(p.Size))
+		}
+	}
+
+	heading = false
+	for _, res := range r.Results {
+		for _, err := range []error{res.Err, res.PackagesErr} {
+			if err == nil {
+				continue
+			}
+			if !heading {
+				b.WriteString("\n## Errores\n")
+				heading = true
+			}
+			fmt.Fprintf(&b, "\n### %s (%s)\n\n```\n%v\n```\n", res.Lesson, res.Target, err)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteCSV escribe una fila por lección y plataforma. Los paquetes más
+// pesados van en la columna top_packages como "ruta=bytes" separados por ';'.
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"lesson", "goos", "goarch", "size", "stripped", "top_packages", "error", "packages_error"})
+	for _, res := range r.Results {
+		var top []string
+		for _, p := range res.Packages {
+			top = append(top, p.Path+"="+strconv.FormatInt(p.Size, 10))
+		}
+		cw.Write([]string{
+			res.Lesson, res.Target.GOOS, res.Target.GOARCH,
+			strconv.FormatInt(res.Size, 10), strconv.FormatInt(res.Stripped, 10),
+			strings.Join(top, ";"), errText(res.Err), errText(res.PackagesErr),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func errText(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+func savings(res Result) float64 {
+	if res.Size == 0 {
+		return 0
+	}
+	return 100 * float64(res.Size-res.Stripped) / float64(res.Size)
+}
+
+// humanSize formatea bytes en KiB o MiB.
+func humanSize(n int64) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.2f MiB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KiB", float64(n)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", n)
+	}
+}
@@ -0,0 +1,461 @@
+// Package globals busca el estado mutable a nivel de paquete: variables
+// globales que se modifican después de inicializarse, las funciones que las
+// modifican y si alguna goroutine puede llegar a esas escrituras.
+//
+// El análisis es de un solo paquete y sigue solo las llamadas estáticas:
+// funciones y métodos del paquete, funciones literales y variables globales
+// de tipo función. No ve las escrituras a través de un puntero a una global
+// que se pasa a otro paquete (ej. pretty.registry modificado dentro de
+// dispatch.Register), ni lo que hace un método a través de su receptor si la
+// global ya es un puntero. Las sentencias go con un destino dinámico
+// (una variable local, un campo o un método de interfaz) se informan en
+// Report.UnknownGo.
+package globals
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sort"
+	"strconv"
+
+	"github.com/FepDev25/gobootcamp/internal/loader"
+)
+
+// Var es una variable a nivel de paquete.
+type Var struct {
+	Name     string
+	Type     string
+	Pos      token.Position
+	Exported bool // Otros paquetes también pueden modificarla; no se revisan
+	Writes   []Write
+}
+
+// Mutable indica si la variable se modifica fuera de su inicializador.
+func (v *Var) Mutable() bool { return len(v.Writes) > 0 }
+
+// Concurrent indica si alguna escritura es alcanzable desde una goroutine.
+func (v *Var) Concurrent() bool {
+	for _, w := range v.Writes {
+		if w.Goroutine != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Write es una modificación de una variable.
+type Write struct {
+	Pos  token.Position
+	Func string // Función que la contiene; "init" para las funciones init
+	Kind string // asignación, incremento, elemento, dirección, método...
+	// Goroutine describe cómo se llega a la escritura desde una sentencia
+	// go, por ejemplo "go worker → guardar"; vacío si no se llega.
+	Goroutine string
+}
+
+// Report es el resultado para un paquete.
+type Report struct {
+	Dir  string
+	Name string
+	Vars []*Var // En el orden del código
+	// UnknownGo son las sentencias go cuyo destino no se puede resolver;
+	// sus escrituras no se marcan como alcanzables desde una goroutine.
+	UnknownGo []token.Position
+}
+
+// Mutable devuelve las variables que se modifican.
+func (r *Report) Mutable() []*Var {
+	var out []*Var
+	for _, v := range r.Vars {
+		if v.Mutable() {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// Check revisa pkg. Las posiciones son relativas a base.
+func Check(pkg *loader.Package, base string) *Report {
+	c := &checker{
+		pkg:      pkg,
+		base:     base,
+		vars:     make(map[*types.Var]*Var),
+		calls:    make(map[any][]any),
+		names:    make(map[any]string),
+		litCount: make(map[any]int),
+	}
+
+	r := &Report{Dir: pkg.Dir, Name: pkg.Types.Name()}
+	scope := pkg.Types.Scope()
+	for _, name := range scope.Names() {
+		obj, ok := scope.Lookup(name).(*types.Var)
+		if !ok || name == "_" {
+			continue
+		}
+		v := &Var{
+			Name:     name,
+			Type:     types.TypeString(obj.Type(), qualifier(pkg.Types)),
+			Pos:      pkg.Position(base, obj.Pos()),
+			Exported: obj.Exported() && pkg.Types.Name() != "main",
+		}
+		c.vars[obj] = v
+		r.Vars = append(r.Vars, v)
+	}
+	sort.Slice(r.Vars, func(i, j int) bool {
+		a, b := r.Vars[i].Pos, r.Vars[j].Pos
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		return a.Offset < b.Offset
+	})
+
+	for _, f := range pkg.Files {
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Body != nil {
+					node := c.funcNode(decl)
+					c.names[node] = funcName(decl)
+					c.walk(decl.Body, node)
+				}
+			case *ast.GenDecl:
+				c.walkInitializers(decl)
+			}
+		}
+	}
+	c.markGoroutines()
+	r.UnknownGo = c.unknownGo
+	return r
+}
+
+// walkInitializers recorre los valores iniciales de las globales, que
+// pueden ser funciones literales: var handler = func() { ... }.
+func (c *checker) walkInitializers(decl *ast.GenDecl) {
+	if decl.Tok != token.VAR {
+		return
+	}
+	for _, spec := range decl.Specs {
+		vs := spec.(*ast.ValueSpec)
+		for i, value := range vs.Values {
+			// Con var a, b = f() no hay un valor por nombre; se atribuye al
+			// primero
+			name := vs.Names[min(i, len(vs.Names)-1)]
+			obj, ok := c.pkg.Info.Defs[name].(*types.Var)
+			if !ok {
+				continue
+			}
+			c.names[obj] = name.Name
+			c.walk(value, obj)
+		}
+	}
+}
+
+type checker struct {
+	pkg  *loader.Package
+	base string
+	vars map[*types.Var]*Var
+
+	// Grafo de llamadas dentro del paquete. Los nodos son *types.Func para
+	// las funciones declaradas, *ast.FuncLit para las literales y *types.Var
+	// para las globales de tipo función, que llaman a las literales que se
+	// les asignan.
+	calls     map[any][]any
+	names     map[any]string
+	litCount  map[any]int // Funciones literales numeradas por función
+	roots     []root      // Destinos de las sentencias go
+	unknownGo []token.Position
+	writes    []pending
+}
+
+type root struct {
+	node any
+	name string
+}
+
+type pending struct {
+	v    *Var
+	node any
+	w    Write
+}
+
+func (c *checker) funcNode(fd *ast.FuncDecl) any {
+	if obj := c.pkg.Info.Defs[fd.Name]; obj != nil {
+		return obj
+	}
+	return fd
+}
+
+func qualifier(pkg *types.Package) types.Qualifier {
+	return func(p *types.Package) string {
+		if p == pkg {
+			return ""
+		}
+		return p.Name()
+	}
+}
+
+func funcName(fd *ast.FuncDecl) string {
+	if fd.Recv != nil && len(fd.Recv.List) > 0 {
+		return types.ExprString(fd.Recv.List[0].Type) + "." + fd.Name.Name
+	}
+	return fd.Name.Name
+}
+
+// walk recorre el cuerpo de la función node registrando escrituras,
+// llamadas y sentencias go.
+func (c *checker) walk(body ast.Node, node any) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			// Una función literal puede llamarse desde donde se declara
+			c.nameLiteral(n, node)
+			c.calls[node] = append(c.calls[node], n)
+			c.walk(n.Body, n)
+			return false
+		case *ast.GoStmt:
+			if lit, ok := n.Call.Fun.(*ast.FuncLit); ok {
+				c.nameLiteral(lit, node)
+			}
+			if target := c.callee(n.Call.Fun); target != nil {
+				c.roots = append(c.roots, root{node: target, name: c.describe(target)})
+			} else if c.dynamic(n.Call.Fun) {
+				c.unknownGo = append(c.unknownGo, c.pkg.Position(c.base, n.Go))
+			}
+		case *ast.CallExpr:
+			if target := c.callee(n.Fun); target != nil {
+				c.calls[node] = append(c.calls[node], target)
+			}
+			c.methodWrite(n, node)
+		case *ast.AssignStmt:
+			if n.Tok != token.DEFINE {
+				for i, lhs := range n.Lhs {
+					c.write(lhs, node, "asignación")
+					if len(n.Rhs) == len(n.Lhs) {
+						c.assignFunc(lhs, n.Rhs[i])
+					}
+				}
+			}
+		case *ast.IncDecStmt:
+			c.write(n.X, node, "incremento")
+		case *ast.RangeStmt:
+			if n.Tok == token.ASSIGN {
+				for _, e := range []ast.Expr{n.Key, n.Value} {
+					if e != nil {
+						c.write(e, node, "asignación en range")
+					}
+				}
+			}
+		case *ast.UnaryExpr:
+			if n.Op == token.AND {
+				c.write(n.X, node, "dirección tomada (&)")
+			}
+		}
+		return true
+	})
+}
+
+// nameLiteral nombra lit como lo hace el compilador: main.func1,
+// main.func2, y main.func1.1 para una literal dentro de otra.
+func (c *checker) nameLiteral(lit *ast.FuncLit, parent any) {
+	if _, ok := c.names[lit]; ok {
+		return
+	}
+	c.litCount[parent]++
+	sep := ".func"
+	if _, nested := parent.(*ast.FuncLit); nested {
+		sep = "."
+	}
+	c.names[lit] = c.describe(parent) + sep + strconv.Itoa(c.litCount[parent])
+}
+
+// assignFunc registra handler = func() { ... } sobre una global de tipo
+// función: llamar a handler puede ejecutar esa literal.
+func (c *checker) assignFunc(lhs, rhs ast.Expr) {
+	lit, ok := ast.Unparen(rhs).(*ast.FuncLit)
+	if !ok {
+		return
+	}
+	if v := c.funcVar(lhs); v != nil {
+		c.calls[v] = append(c.calls[v], lit)
+	}
+}
+
+// funcVar devuelve la global de tipo función que nombra e, si la hay.
+func (c *checker) funcVar(e ast.Expr) *types.Var {
+	var id *ast.Ident
+	switch e := ast.Unparen(e).(type) {
+	case *ast.Ident:
+		id = e
+	case *ast.SelectorExpr:
+		id = e.Sel
+	default:
+		return nil
+	}
+	v, ok := c.pkg.Info.Uses[id].(*types.Var)
+	if !ok || c.vars[v] == nil {
+		return nil
+	}
+	if _, ok := v.Type().Underlying().(*types.Signature); !ok {
+		return nil
+	}
+	if _, ok := c.names[v]; !ok {
+		c.names[v] = v.Name()
+	}
+	return v
+}
+
+// callee devuelve el nodo del grafo al que llama fun, si es una función
+// del paquete, una función literal o una global de tipo función.
+func (c *checker) callee(fun ast.Expr) any {
+	if v := c.funcVar(fun); v != nil {
+		return v
+	}
+	switch fun := ast.Unparen(fun).(type) {
+	case *ast.FuncLit:
+		return fun
+	case *ast.Ident:
+		if fn, ok := c.pkg.Info.Uses[fun].(*types.Func); ok && fn.Pkg() == c.pkg.Types {
+			return fn
+		}
+	case *ast.SelectorExpr:
+		if fn, ok := c.pkg.Info.Uses[fun.Sel].(*types.Func); ok && fn.Pkg() == c.pkg.Types {
+			return fn
+		}
+	}
+	return nil
+}
+
+// dynamic indica si fun es un valor que se conoce solo al ejecutar: una
+// variable local, un campo, un parámetro o un método de interfaz. Las
+// funciones de otros paquetes no lo son.
+func (c *checker) dynamic(fun ast.Expr) bool {
+	var id *ast.Ident
+	switch fun := ast.Unparen(fun).(type) {
+	case *ast.Ident:
+		id = fun
+	case *ast.SelectorExpr:
+		id = fun.Sel
+	default:
+		return true // Ej. go handlers[i]() o go f()()
+	}
+	fn, ok := c.pkg.Info.Uses[id].(*types.Func)
+	if !ok {
+		return true
+	}
+	recv := fn.Type().(*types.Signature).Recv()
+	return recv != nil && types.IsInterface(recv.Type())
+}
+
+func (c *checker) describe(node any) string {
+	if name, ok := c.names[node]; ok {
+		return name
+	}
+	if fn, ok := node.(*types.Func); ok {
+		return fn.Name()
+	}
+	return "func literal"
+}
+
+// methodWrite registra las llamadas a métodos con receptor puntero sobre
+// una global que no es un puntero: la llamada toma su dirección y puede
+// modificarla. Si la global ya es un puntero, el método modifica lo
+// apuntado, no la variable.
+func (c *checker) methodWrite(call *ast.CallExpr, node any) {
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+	s, ok := c.pkg.Info.Selections[sel]
+	if !ok || s.Kind() != types.MethodVal {
+		return
+	}
+	sig := s.Obj().Type().(*types.Signature)
+	if sig.Recv() == nil {
+		return
+	}
+	if _, ptr := sig.Recv().Type().(*types.Pointer); !ptr || s.Indirect() {
+		return
+	}
+	if _, ptr := c.pkg.Info.TypeOf(sel.X).Underlying().(*types.Pointer); ptr {
+		return
+	}
+	// mu.Lock() o wg.Add(1) sobre una global son sincronización, no estado
+	if pkg := s.Obj().Pkg(); pkg != nil && (pkg.Path() == "sync" || pkg.Path() == "sync/atomic") {
+		return
+	}
+	c.write(sel.X, node, "método "+s.Obj().Name())
+}
+
+// write registra una escritura si e es una global o una parte de ella
+// (campo, elemento o lo apuntado).
+func (c *checker) write(e ast.Expr, node any, kind string) {
+	id, part := rootIdent(e)
+	if id == nil {
+		return
+	}
+	obj, ok := c.pkg.Info.Uses[id].(*types.Var)
+	if !ok {
+		return
+	}
+	v, ok := c.vars[obj]
+	if !ok {
+		return
+	}
+	if part != "" {
+		kind += " (" + part + ")"
+	}
+	c.writes = append(c.writes, pending{v: v, node: node, w: Write{
+		Pos:  c.pkg.Position(c.base, e.Pos()),
+		Func: c.describe(node),
+		Kind: kind,
+	}})
+}
+
+// rootIdent devuelve la variable en la raíz de e y qué parte se modifica.
+func rootIdent(e ast.Expr) (*ast.Ident, string) {
+	part := ""
+	for {
+		switch x := ast.Unparen(e).(type) {
+		case *ast.Ident:
+			return x, part
+		case *ast.SelectorExpr:
+			part, e = "campo", x.X
+		case *ast.IndexExpr:
+			part, e = "elemento", x.X
+		case *ast.StarExpr:
+			part, e = "valor apuntado", x.X
+		default:
+			return nil, ""
+		}
+	}
+}
+
+// markGoroutines recorre el grafo desde cada sentencia go y completa las
+// escrituras alcanzables con el camino que lleva a ellas.
+func (c *checker) markGoroutines() {
+	paths := make(map[any]string)
+	for _, r := range c.roots {
+		if _, seen := paths[r.node]; seen {
+			continue
+		}
+		paths[r.node] = "go " + r.name
+		queue := []any{r.node}
+		for len(queue) > 0 {
+			n := queue[0]
+			queue = queue[1:]
+			for _, next := range c.calls[n] {
+				if _, seen := paths[next]; seen {
+					continue
+				}
+				paths[next] = paths[n] + " → " + c.describe(next)
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	for _, p := range c.writes {
+		p.w.Goroutine = paths[p.node]
+		p.v.Writes = append(p.v.Writes, p.w)
+	}
+}
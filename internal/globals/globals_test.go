@@ -0,0 +1,87 @@
+package globals
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/FepDev25/gobootcamp/internal/loader"
+)
+
+func TestCheck(t *testing.T) {
+	dir := filepath.Join("testdata", "state")
+	pkg, err := loader.LoadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := Check(pkg, pkg.Dir)
+
+	// Una línea por escritura: "var: tipo en función [go camino]"
+	got := make(map[string][]string)
+	for _, v := range r.Vars {
+		for _, w := range v.Writes {
+			line := w.Kind + " en " + w.Func
+			if w.Goroutine != "" {
+				line += " [" + w.Goroutine + "]"
+			}
+			got[v.Name] = append(got[v.Name], line)
+		}
+	}
+	want := map[string][]string{
+		"counter": {"incremento en add [go worker → add]", "asignación en Start.func2 [go handler → Start.func2]"},
+		"cache":   {"incremento (elemento) en add [go worker → add]"},
+		"buf":     {"método WriteString en Start.func1 [go Start.func1]"},
+		"ready":   {"asignación en init"},
+		"items":   {"asignación en onDone.func1 [go onDone → onDone.func1]"},
+		"Name":    {"asignación en Start.func3"},
+		"handler": {"asignación en Start"},
+	}
+	for name, lines := range want {
+		if strings.Join(got[name], "; ") != strings.Join(lines, "; ") {
+			t.Errorf("%s:\n  %s\nse esperaba:\n  %s", name, strings.Join(got[name], "\n  "), strings.Join(lines, "\n  "))
+		}
+		delete(got, name)
+	}
+	// mu y wg solo se usan con sus métodos; limit solo se lee
+	for name, lines := range got {
+		t.Errorf("%s: escrituras inesperadas %v", name, lines)
+	}
+
+	if len(r.UnknownGo) != 1 || r.UnknownGo[0].Line != 46 {
+		t.Errorf("UnknownGo = %v, se esperaba la línea 46 (go fns[0]())", r.UnknownGo)
+	}
+
+	var mutable []string
+	for _, v := range r.Mutable() {
+		mutable = append(mutable, v.Name)
+	}
+	if want := "counter cache buf ready items Name handler"; strings.Join(mutable, " ") != want {
+		t.Errorf("Mutable = %v, se esperaba %s", mutable, want)
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	dir := filepath.Join("testdata", "state")
+	pkg, err := loader.LoadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	r := Check(pkg, pkg.Dir)
+	r.Dir = dir
+	if err := WriteText(&b, []*Report{r}, true); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+	for _, s := range []string{
+		"Paquete testdata/state (state): 11 globales, 7 mutables",
+		"exportada: otros paquetes pueden modificarla",
+		"state.go:28:2: incremento en add\n      ⚠ alcanzable desde una goroutine: go worker → add",
+		"⚠ state.go:46:2: go con un destino dinámico",
+		"solo lectura: mu, wg, limit, onDone",
+	} {
+		if !strings.Contains(out, s) {
+			t.Errorf("falta %q en:\n%s", s, out)
+		}
+	}
+}
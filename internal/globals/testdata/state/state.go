@@ -0,0 +1,52 @@
+package state
+
+import (
+	"bytes"
+	"sync"
+)
+
+var (
+	counter int
+	cache   = map[string]int{}
+	buf     bytes.Buffer
+	ready   bool
+	items   []string
+	mu      sync.Mutex
+	wg      sync.WaitGroup
+	limit   = 10
+	Name    = "x"
+	handler func()
+	onDone  = func() { items = append(items, "fin") }
+)
+
+func init() { ready = true }
+
+func add(k string) {
+	mu.Lock()
+	defer mu.Unlock()
+	cache[k]++
+	counter++
+}
+
+func worker() {
+	defer wg.Done()
+	add("a")
+}
+
+func Start(fns []func()) {
+	wg.Add(2)
+	go worker()
+	go func() {
+		defer wg.Done()
+		buf.WriteString("hola")
+	}()
+	handler = func() { counter = 5 }
+	go handler()
+	go onDone()
+	go fns[0]()
+	func() {
+		Name = "y"
+	}()
+	wg.Wait()
+	_ = limit
+}
@@ -0,0 +1,54 @@
+package globals
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteText escribe el reporte de cada paquete. Con all también lista las
+// variables que no se modifican.
+func WriteText(w io.Writer, reports []*Report, all bool) error {
+	var b strings.Builder
+	for i, r := range reports {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		mutable := r.Mutable()
+		fmt.Fprintf(&b, "Paquete %s (%s): %d globales, %d mutables\n", r.Dir, r.Name, len(r.Vars), len(mutable))
+
+		for _, v := range r.Vars {
+			if !v.Mutable() {
+				continue
+			}
+			fmt.Fprintf(&b, "  var %s %s (%s)\n", v.Name, v.Type, v.Pos)
+			if v.Exported {
+				b.WriteString("    exportada: otros paquetes pueden modificarla\n")
+			}
+			for _, wr := range v.Writes {
+				fmt.Fprintf(&b, "    %s: %s en %s\n", wr.Pos, wr.Kind, wr.Func)
+				if wr.Goroutine != "" {
+					fmt.Fprintf(&b, "      ⚠ alcanzable desde una goroutine: %s\n", wr.Goroutine)
+				}
+			}
+		}
+
+		for _, pos := range r.UnknownGo {
+			fmt.Fprintf(&b, "  ⚠ %s: go con un destino dinámico; sus escrituras no se pueden seguir\n", pos)
+		}
+
+		if all {
+			var readOnly []string
+			for _, v := range r.Vars {
+				if !v.Mutable() {
+					readOnly = append(readOnly, v.Name)
+				}
+			}
+			if len(readOnly) > 0 {
+				fmt.Fprintf(&b, "  solo lectura: %s\n", strings.Join(readOnly, ", "))
+			}
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
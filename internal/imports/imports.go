@@ -0,0 +1,198 @@
+// Package imports arma el grafo de importaciones de cada lección: los
+// paquetes que importa directamente, cuántas veces usa cada uno y todo lo
+// que arrastran de forma transitiva.
+package imports
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os/exec"
+	"path"
+	"sort"
+	"strconv"
+
+	"github.com/FepDev25/gobootcamp/internal/lessons"
+)
+
+// Heavy son los paquetes que conviene no importar para una sola llamada:
+// agregan muchas dependencias o trabajan en tiempo de ejecución con reflexión.
+var Heavy = map[string]bool{
+	"net/http":      true,
+	"reflect":       true,
+	"encoding/json": true,
+	"encoding/xml":  true,
+	"text/template": true,
+	"html/template": true,
+	"database/sql":  true,
+}
+
+// Package es un nodo del grafo.
+type Package struct {
+	Path     string
+	Name     string
+	Standard bool
+	Imports  []string
+}
+
+// Import es una importación directa de la lección.
+type Import struct {
+	Path  string
+	Alias string // Nombre local si difiere del del paquete, ej. red para net/http
+	Uses  int    // Selectores pkg.X en el código; -1 para importaciones _ o .
+	Deps  int    // Paquetes que arrastra de forma transitiva, sin contarse a sí mismo
+	Heavy bool   // Es un paquete pesado usado una sola vez
+}
+
+// Graph es el grafo de importaciones de una lección.
+type Graph struct {
+	Lesson   string
+	Direct   []Import
+	Packages map[string]*Package // Todas las dependencias, por ruta
+}
+
+// Analyze arma el grafo de la lección. Las importaciones directas y sus
+// usos salen de los archivos fuente; las transitivas, de go list -deps.
+func Analyze(ctx context.Context, l lessons.Lesson) (*Graph, error) {
+	pkgs, err := listDeps(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+	g := &Graph{Lesson: l.Name, Packages: pkgs}
+
+	direct := make(map[string]*Import)
+	fset := token.NewFileSet()
+	for _, file := range l.Paths() {
+		f, err := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for local, importPath := range g.fileImports(f, direct) {
+			ast.Inspect(f, func(n ast.Node) bool {
+				sel, ok := n.(*ast.SelectorExpr)
+				if !ok {
+					return true
+				}
+				// Sin resolver tipos, un identificador local que se llame
+				// igual que el paquete también se cuenta
+				if id, ok := sel.X.(*ast.Ident); ok && id.Name == local {
+					direct[importPath].Uses++
+				}
+				return true
+			})
+		}
+	}
+
+	for _, imp := range direct {
+		imp.Deps = len(g.reachable(imp.Path)) - 1
+		imp.Heavy = Heavy[imp.Path] && imp.Uses == 1
+		g.Direct = append(g.Direct, *imp)
+	}
+	sort.Slice(g.Direct, func(i, j int) bool { return g.Direct[i].Path < g.Direct[j].Path })
+	return g, nil
+}
+
+// fileImports registra las importaciones de f en direct y devuelve el nombre
+// local con que se usa cada una en el archivo.
+func (g *Graph) fileImports(f *ast.File, direct map[string]*Import) map[string]string {
+	locals := make(map[string]string)
+	for _, spec := range f.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		imp, ok := direct[importPath]
+		if !ok {
+			imp = &Import{Path: importPath}
+			direct[importPath] = imp
+		}
+
+		name := path.Base(importPath)
+		if p, ok := g.Packages[importPath]; ok && p.Name != "" {
+			name = p.Name
+		}
+		local := name
+		if spec.Name != nil {
+			local = spec.Name.Name
+		}
+		switch local {
+		case "_", ".":
+			imp.Uses = -1
+			continue
+		}
+		if local != name {
+			imp.Alias = local
+		}
+		locals[local] = importPath
+	}
+	return locals
+}
+
+// reachable devuelve los paquetes alcanzables desde from, incluido él mismo.
+func (g *Graph) reachable(from string) map[string]bool {
+	seen := make(map[string]bool)
+	var visit func(string)
+	visit = func(p string) {
+		if seen[p] {
+			return
+		}
+		seen[p] = true
+		if pkg, ok := g.Packages[p]; ok {
+			for _, dep := range pkg.Imports {
+				visit(dep)
+			}
+		}
+	}
+	visit(from)
+	return seen
+}
+
+// Counts devuelve cuántos paquetes importa la lección directamente, cuántos
+// en total y cuántos de ellos son de la biblioteca estándar.
+func (g *Graph) Counts() (direct, total, standard int) {
+	seen := make(map[string]bool)
+	for _, imp := range g.Direct {
+		for p := range g.reachable(imp.Path) {
+			seen[p] = true
+		}
+	}
+	for p := range seen {
+		if pkg, ok := g.Packages[p]; ok && pkg.Standard {
+			standard++
+		}
+	}
+	return len(g.Direct), len(seen), standard
+}
+
+// listDeps ejecuta go list -deps sobre los archivos de la lección.
+func listDeps(ctx context.Context, l lessons.Lesson) (map[string]*Package, error) {
+	args := append([]string{"list", "-e", "-deps", "-json=ImportPath,Name,Standard,Imports", "--"}, l.Files...)
+	cmd := exec.CommandContext(ctx, "go", args...)
+	cmd.Dir = l.Dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("%s: go list: %v\n%s", l.Name, err, stderr.Bytes())
+	}
+
+	pkgs := make(map[string]*Package)
+	dec := json.NewDecoder(bytes.NewReader(out))
+	for dec.More() {
+		var p struct {
+			ImportPath string
+			Name       string
+			Standard   bool
+			Imports    []string
+		}
+		if err := dec.Decode(&p); err != nil {
+			return nil, err
+		}
+		pkgs[p.ImportPath] = &Package{Path: p.ImportPath, Name: p.Name, Standard: p.Standard, Imports: p.Imports}
+	}
+	return pkgs, nil
+}
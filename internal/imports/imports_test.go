@@ -0,0 +1,70 @@
+package imports
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/FepDev25/gobootcamp/internal/lessons"
+	"github.com/FepDev25/gobootcamp/internal/testutil"
+)
+
+// La lección de prueba solo importa paquetes de testdata, así la salida no
+// cambia con la versión de Go.
+const testdataPkg = "github.com/FepDev25/gobootcamp/internal/imports/testdata/pkg/"
+
+func analyze(t *testing.T) []*Graph {
+	t.Helper()
+	// words se usa una sola vez; como pesado debe aparecer en la advertencia
+	Heavy[testdataPkg+"words"] = true
+	t.Cleanup(func() { delete(Heavy, testdataPkg+"words") })
+
+	found, err := lessons.Find("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 1 {
+		t.Fatalf("se encontraron %d lecciones en testdata, se esperaba 1", len(found))
+	}
+	g, err := Analyze(context.Background(), found[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return []*Graph{g}
+}
+
+func TestAnalyze(t *testing.T) {
+	g := analyze(t)[0]
+	want := []Import{
+		{Path: testdataPkg + "greet", Alias: "saludo", Uses: 2, Deps: 1},
+		{Path: testdataPkg + "setup", Uses: -1},
+		{Path: testdataPkg + "words", Uses: 1, Heavy: true},
+	}
+	if len(g.Direct) != len(want) {
+		t.Fatalf("Direct = %+v, se esperaba %+v", g.Direct, want)
+	}
+	for i := range want {
+		if g.Direct[i] != want[i] {
+			t.Errorf("Direct[%d] = %+v, se esperaba %+v", i, g.Direct[i], want[i])
+		}
+	}
+	if direct, total, standard := g.Counts(); direct != 3 || total != 3 || standard != 0 {
+		t.Errorf("Counts() = %d, %d, %d, se esperaba 3, 3, 0", direct, total, standard)
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteText(&buf, analyze(t), 2); err != nil {
+		t.Fatal(err)
+	}
+	testutil.Golden(t, "imports.golden.txt", buf.Bytes())
+}
+
+func TestWriteDOT(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteDOT(&buf, analyze(t), 2); err != nil {
+		t.Fatal(err)
+	}
+	testutil.Golden(t, "imports.golden.dot", buf.Bytes())
+}
@@ -0,0 +1,161 @@
+package imports
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// WriteText escribe un árbol por lección. depth indica cuántos niveles de
+// dependencias se expanden: 1 muestra solo las importaciones directas.
+func WriteText(w io.Writer, graphs []*Graph, depth int) error {
+	var heavy []string
+	for _, g := range graphs {
+		direct, total, standard := g.Counts()
+		fmt.Fprintf(w, "%s  (%d directas, %d paquetes en total, %d de la estándar)\n", g.Lesson, direct, total, standard)
+
+		expanded := make(map[string]bool)
+		for i, imp := range g.Direct {
+			last := i == len(g.Direct)-1
+			line := imp.Path
+			if imp.Alias != "" {
+				line += " como " + imp.Alias
+			}
+			if imp.Uses >= 0 {
+				line += fmt.Sprintf("  ×%d", imp.Uses)
+			}
+			line += fmt.Sprintf("  (+%d)", imp.Deps)
+			if imp.Heavy {
+				line += "  ⚠ pesado para un solo uso"
+				heavy = append(heavy, g.Lesson+": "+imp.Path)
+			}
+			fmt.Fprintln(w, branch(last)+line)
+			g.writeChildren(w, imp.Path, indent(last), depth-1, expanded)
+		}
+		fmt.Fprintln(w)
+	}
+
+	if len(heavy) > 0 {
+		fmt.Fprintln(w, "Paquetes pesados importados para una sola llamada:")
+		for _, h := range heavy {
+			fmt.Fprintln(w, "  "+h)
+		}
+	}
+	return nil
+}
+
+func (g *Graph) writeChildren(w io.Writer, from, prefix string, depth int, expanded map[string]bool) {
+	pkg, ok := g.Packages[from]
+	if depth <= 0 || !ok {
+		return
+	}
+	// Un paquete ya expandido no se repite, como en go mod graph
+	if expanded[from] {
+		if len(pkg.Imports) > 0 {
+			fmt.Fprintln(w, prefix+"└── …")
+		}
+		return
+	}
+	expanded[from] = true
+	for i, dep := range pkg.Imports {
+		last := i == len(pkg.Imports)-1
+		fmt.Fprintln(w, prefix+branch(last)+dep)
+		g.writeChildren(w, dep, prefix+indent(last), depth-1, expanded)
+	}
+}
+
+func branch(last bool) string {
+	if last {
+		return "└── "
+	}
+	return "├── "
+}
+
+func indent(last bool) string {
+	if last {
+		return "    "
+	}
+	return "│   "
+}
+
+// WriteDOT escribe todas las lecciones en un solo grafo de Graphviz. Las
+// aristas rojas marcan paquetes pesados usados una sola vez.
+func WriteDOT(w io.Writer, graphs []*Graph, depth int) error {
+	fmt.Fprintln(w, "digraph imports {")
+	fmt.Fprintln(w, "\trankdir=LR;")
+	fmt.Fprintln(w, "\tnode [shape=box, fontname=\"monospace\"];")
+
+	nodes := make(map[string]*Package)
+	edges := make(map[[2]string]bool)
+	for _, g := range graphs {
+		direct, total, standard := g.Counts()
+		fmt.Fprintf(w, "\t%s [shape=folder, style=bold, label=%s];\n", quote("lesson:"+g.Lesson),
+			quote(fmt.Sprintf("%s\n%d directas, %d paquetes, %d estándar", g.Lesson, direct, total, standard)))
+
+		for _, imp := range g.Direct {
+			var attrs []string
+			if imp.Uses >= 0 {
+				attrs = append(attrs, "label="+quote(fmt.Sprintf("×%d", imp.Uses)))
+			}
+			if imp.Heavy {
+				attrs = append(attrs, "color=red", "fontcolor=red")
+			}
+			fmt.Fprintf(w, "\t%s -> %s [%s];\n", quote("lesson:"+g.Lesson), quote(imp.Path), strings.Join(attrs, ", "))
+			g.collect(imp.Path, depth-1, nodes, edges)
+		}
+	}
+
+	paths := make([]string, 0, len(nodes))
+	for p := range nodes {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		style := ""
+		if nodes[p].Standard {
+			style = ", style=filled, fillcolor=\"#eeeeee\""
+		}
+		fmt.Fprintf(w, "\t%s [label=%s%s];\n", quote(p), quote(p), style)
+	}
+
+	var list [][2]string
+	for e := range edges {
+		list = append(list, e)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i][0] != list[j][0] {
+			return list[i][0] < list[j][0]
+		}
+		return list[i][1] < list[j][1]
+	})
+	for _, e := range list {
+		fmt.Fprintf(w, "\t%s -> %s;\n", quote(e[0]), quote(e[1]))
+	}
+	_, err := fmt.Fprintln(w, "}")
+	return err
+}
+
+// collect agrega from y sus dependencias hasta depth niveles.
+func (g *Graph) collect(from string, depth int, nodes map[string]*Package, edges map[[2]string]bool) {
+	pkg, ok := g.Packages[from]
+	if !ok {
+		pkg = &Package{Path: from}
+	}
+	nodes[from] = pkg
+	if depth <= 0 {
+		return
+	}
+	for _, dep := range pkg.Imports {
+		if edges[[2]string{from, dep}] {
+			continue
+		}
+		edges[[2]string{from, dep}] = true
+		g.collect(dep, depth-1, nodes, edges)
+	}
+}
+
+func quote(s string) string {
+	return strconv.Quote(s)
+}
@@ -0,0 +1,12 @@
+package main
+
+import (
+	saludo "github.com/FepDev25/gobootcamp/internal/imports/testdata/pkg/greet"
+	_ "github.com/FepDev25/gobootcamp/internal/imports/testdata/pkg/setup"
+	"github.com/FepDev25/gobootcamp/internal/imports/testdata/pkg/words"
+)
+
+func main() {
+	saludo.Hello(words.World)
+	saludo.Bye("todos")
+}
@@ -0,0 +1,7 @@
+package greet
+
+import "github.com/FepDev25/gobootcamp/internal/imports/testdata/pkg/words"
+
+func Hello(name string) string { return words.Join("hola", name) }
+
+func Bye(name string) string { return words.Join("chao", name) }
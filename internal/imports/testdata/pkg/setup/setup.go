@@ -0,0 +1,5 @@
+package setup
+
+var Ready bool
+
+func init() { Ready = true }
@@ -0,0 +1,5 @@
+package words
+
+const World = "mundo"
+
+func Join(a, b string) string { return a + ", " + b }
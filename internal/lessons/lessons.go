@@ -0,0 +1,105 @@
+// Package lessons localiza las lecciones ejecutables del bootcamp.
+//
+// Una lección es un directorio cuyo camino está formado solo por carpetas
+// numeradas (01_hello_world, 02_basics/07_loops, ...) y que contiene un
+// paquete main.
+package lessons
+
+import (
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var numbered = regexp.MustCompile(`^\d\d_`)
+
+// Lesson describe una lección encontrada en el repositorio.
+type Lesson struct {
+	Name  string   // Ruta relativa a la raíz, ej. 02_basics/01_imports
+	Dir   string   // Ruta absoluta del directorio
+	Files []string // Archivos .go del paquete, sin los _test.go
+}
+
+// Find recorre root y devuelve las lecciones ordenadas por nombre.
+func Find(root string) ([]Lesson, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []Lesson
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() || path == root {
+			return nil
+		}
+		if !numbered.MatchString(d.Name()) {
+			return filepath.SkipDir
+		}
+
+		lesson, ok, err := load(root, path)
+		if err != nil {
+			return err
+		}
+		if ok {
+			found = append(found, lesson)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(found, func(i, j int) bool { return found[i].Name < found[j].Name })
+	return found, nil
+}
+
+// load devuelve la lección del directorio dir si contiene un paquete main.
+func load(root, dir string) (Lesson, bool, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return Lesson{}, false, err
+	}
+
+	var files []string
+	fset := token.NewFileSet()
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.PackageClauseOnly)
+		if err != nil {
+			return Lesson{}, false, err
+		}
+		if f.Name.Name != "main" {
+			return Lesson{}, false, nil
+		}
+		files = append(files, name)
+	}
+	if len(files) == 0 {
+		return Lesson{}, false, nil
+	}
+
+	rel, err := filepath.Rel(root, dir)
+	if err != nil {
+		return Lesson{}, false, err
+	}
+	return Lesson{Name: filepath.ToSlash(rel), Dir: dir, Files: files}, true, nil
+}
+
+// Paths devuelve las rutas absolutas de los archivos de la lección.
+func (l Lesson) Paths() []string {
+	paths := make([]string, len(l.Files))
+	for i, f := range l.Files {
+		paths[i] = filepath.Join(l.Dir, f)
+	}
+	return paths
+}
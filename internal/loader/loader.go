@@ -0,0 +1,219 @@
+// Package loader analiza y verifica los tipos de un paquete de Go a partir
+// de sus archivos, para las herramientas que inspeccionan código fuente.
+package loader
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Package es un paquete analizado y con sus tipos resueltos.
+type Package struct {
+	Dir       string
+	Filenames []string // Rutas absolutas, en el mismo orden que Files
+	Fset      *token.FileSet
+	Files     []*ast.File
+	Types     *types.Package
+	Info      *types.Info
+
+	// TypeErrors contiene los errores de tipos. Las herramientas trabajan
+	// con la información parcial aunque el paquete no compile.
+	TypeErrors []error
+}
+
+// Load analiza los archivos indicados (relativos a dir) como un solo paquete.
+// Las dependencias se importan con los datos de exportación que genera
+// go list, así que funciona con o sin go.mod.
+func Load(dir string, filenames []string) (*Package, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := &Package{
+		Dir:  dir,
+		Fset: token.NewFileSet(),
+		Info: &types.Info{
+			Types:      make(map[ast.Expr]types.TypeAndValue),
+			Defs:       make(map[*ast.Ident]types.Object),
+			Uses:       make(map[*ast.Ident]types.Object),
+			Implicits:  make(map[ast.Node]types.Object),
+			Selections: make(map[*ast.SelectorExpr]*types.Selection),
+			Scopes:     make(map[ast.Node]*types.Scope),
+		},
+	}
+	for _, name := range filenames {
+		path := filepath.Join(dir, name)
+		f, err := parser.ParseFile(pkg.Fset, path, nil, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		pkg.Files = append(pkg.Files, f)
+		pkg.Filenames = append(pkg.Filenames, path)
+	}
+	if len(pkg.Files) == 0 {
+		return nil, fmt.Errorf("%s: no hay archivos para analizar", dir)
+	}
+
+	exports, err := exportData(dir, filenames)
+	if err != nil {
+		return nil, err
+	}
+	// go list no incluye las dependencias de los _test.go nombrados como
+	// archivos; se piden aparte como paquetes
+	var missing []string
+	for _, f := range pkg.Files {
+		for _, imp := range f.Imports {
+			if path, err := strconv.Unquote(imp.Path.Value); err == nil && exports[path] == "" && path != "C" && path != "unsafe" {
+				missing = append(missing, path)
+			}
+		}
+	}
+	if len(missing) > 0 {
+		more, err := exportData(dir, missing)
+		if err != nil {
+			return nil, err
+		}
+		for path, file := range more {
+			exports[path] = file
+		}
+	}
+	conf := types.Config{
+		Importer: importer.ForCompiler(pkg.Fset, "gc", func(path string) (io.ReadCloser, error) {
+			file, ok := exports[path]
+			if !ok {
+				return nil, fmt.Errorf("no se encontraron datos de exportación para %q", path)
+			}
+			return os.Open(file)
+		}),
+		Error: func(err error) { pkg.TypeErrors = append(pkg.TypeErrors, err) },
+	}
+	pkg.Types, _ = conf.Check(pkg.Files[0].Name.Name, pkg.Fset, pkg.Files, pkg.Info)
+	return pkg, nil
+}
+
+// LoadDir analiza los archivos .go de dir que no son de prueba.
+func LoadDir(dir string) (*Package, error) {
+	names, _, err := goFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	return Load(dir, names)
+}
+
+// LoadDirTests analiza los archivos de dir junto con los _test.go del mismo
+// paquete. Las pruebas externas (package x_test) son otro paquete y no se
+// incluyen; ExternalTests las lista.
+func LoadDirTests(dir string) (*Package, error) {
+	names, tests, err := goFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) > 0 {
+		name, err := packageName(filepath.Join(dir, names[0]))
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range tests {
+			if n, err := packageName(filepath.Join(dir, t)); err == nil && n == name {
+				names = append(names, t)
+			}
+		}
+		sort.Strings(names)
+	}
+	return Load(dir, names)
+}
+
+// ExternalTests devuelve los _test.go de dir que declaran el paquete
+// externo de pruebas (package x_test).
+func ExternalTests(dir string) ([]string, error) {
+	_, tests, err := goFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	var external []string
+	for _, t := range tests {
+		if n, err := packageName(filepath.Join(dir, t)); err == nil && strings.HasSuffix(n, "_test") {
+			external = append(external, t)
+		}
+	}
+	return external, nil
+}
+
+// goFiles devuelve los archivos .go de dir, separando los de prueba.
+func goFiles(dir string) (names, tests []string, err error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, e := range entries {
+		name := e.Name()
+		switch {
+		case e.IsDir() || !strings.HasSuffix(name, ".go"):
+		case strings.HasSuffix(name, "_test.go"):
+			tests = append(tests, name)
+		default:
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	sort.Strings(tests)
+	return names, tests, nil
+}
+
+func packageName(path string) (string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.PackageClauseOnly)
+	if err != nil {
+		return "", err
+	}
+	return f.Name.Name, nil
+}
+
+// exportData devuelve, por ruta de importación, el archivo con los datos de
+// exportación de cada dependencia de args, que son archivos o paquetes.
+func exportData(dir string, args []string) (map[string]string, error) {
+	args = append([]string{"list", "-e", "-export", "-deps", "-json=ImportPath,Export", "--"}, args...)
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go list: %v\n%s", err, stderr.Bytes())
+	}
+
+	exports := make(map[string]string)
+	dec := json.NewDecoder(bytes.NewReader(out))
+	for dec.More() {
+		var p struct{ ImportPath, Export string }
+		if err := dec.Decode(&p); err != nil {
+			return nil, err
+		}
+		if p.Export != "" {
+			exports[p.ImportPath] = p.Export
+		}
+	}
+	return exports, nil
+}
+
+// Position devuelve la posición de pos con la ruta relativa a base.
+func (p *Package) Position(base string, pos token.Pos) token.Position {
+	position := p.Fset.Position(pos)
+	if rel, err := filepath.Rel(base, position.Filename); err == nil {
+		position.Filename = filepath.ToSlash(rel)
+	}
+	return position
+}
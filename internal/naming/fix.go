@@ -0,0 +1,221 @@
+package naming
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/types"
+	"os"
+
+	"github.com/FepDev25/gobootcamp/internal/loader"
+)
+
+// Rename es un cambio aplicado por Fix.
+type Rename struct {
+	Finding
+	Uses int // Usos renombrados, sin contar la declaración
+}
+
+// Skip es un hallazgo que Fix no pudo corregir.
+type Skip struct {
+	Finding
+	Reason string
+}
+
+// Fix renombra en pkg cada hallazgo con nombre sugerido, junto con todos sus
+// usos, y devuelve el contenido nuevo de los archivos que cambiaron. Los
+// nombres que podrían romper código fuera del paquete o chocar con otro
+// identificador se dejan como están.
+//
+// pkg debe incluir los _test.go del paquete (loader.LoadDirTests) para que
+// también se renombren sus usos. Las pruebas externas solo ven lo exportado,
+// que Fix nunca renombra fuera de un paquete main. Si pkg tiene errores de
+// tipos Fix no cambia nada: con tipos incompletos podría dejar usos sin
+// renombrar.
+func Fix(pkg *loader.Package, findings []Finding) (files map[string][]byte, renamed []Rename, skipped []Skip, err error) {
+	if len(pkg.TypeErrors) > 0 {
+		return nil, nil, nil, fmt.Errorf("%s: el paquete no compila, no se renombra nada: %w", pkg.Dir, pkg.TypeErrors[0])
+	}
+	uses := make(map[types.Object][]*ast.Ident)
+	for ident, obj := range pkg.Info.Uses {
+		uses[obj] = append(uses[obj], ident)
+	}
+	// Nombres nuevos por ámbito, para que dos cambios no choquen entre sí
+	taken := make(map[*types.Scope]map[string]bool)
+
+	changed := make(map[*ast.File]bool)
+	for _, f := range findings {
+		if f.Suggested == "" {
+			continue
+		}
+		if reason := unsafeRename(pkg, f, uses[f.obj], taken); reason != "" {
+			skipped = append(skipped, Skip{f, reason})
+			continue
+		}
+		if scope := f.obj.Parent(); scope != nil {
+			if taken[scope] == nil {
+				taken[scope] = make(map[string]bool)
+			}
+			taken[scope][f.Suggested] = true
+		}
+
+		f.ident.Name = f.Suggested
+		for _, id := range uses[f.obj] {
+			id.Name = f.Suggested
+		}
+		for _, file := range pkg.Files {
+			if file.Pos() <= f.ident.Pos() && f.ident.Pos() <= file.End() {
+				changed[file] = true
+			}
+			for _, id := range uses[f.obj] {
+				if file.Pos() <= id.Pos() && id.Pos() <= file.End() {
+					changed[file] = true
+				}
+			}
+		}
+		renamed = append(renamed, Rename{f, len(uses[f.obj])})
+	}
+
+	files = make(map[string][]byte)
+	for i, file := range pkg.Files {
+		if !changed[file] {
+			continue
+		}
+		var buf bytes.Buffer
+		if err := format.Node(&buf, pkg.Fset, file); err != nil {
+			return nil, nil, nil, fmt.Errorf("%s: %w", pkg.Filenames[i], err)
+		}
+		files[pkg.Filenames[i]] = buf.Bytes()
+	}
+	return files, renamed, skipped, nil
+}
+
+// WriteFiles guarda los archivos que devolvió Fix.
+func WriteFiles(files map[string][]byte) error {
+	for path, data := range files {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, data, info.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unsafeRename explica por qué no se puede renombrar f, o devuelve "".
+func unsafeRename(pkg *loader.Package, f Finding, uses []*ast.Ident, taken map[*types.Scope]map[string]bool) string {
+	obj, name := f.obj, f.Suggested
+	inMain := pkg.Types.Name() == "main"
+
+	if obj.Exported() && !inMain && !isLocal(obj) {
+		return "es parte de la API del paquete; renombrarlo rompería a quienes lo importan"
+	}
+	switch o := obj.(type) {
+	case *types.Func:
+		if o.Type().(*types.Signature).Recv() != nil {
+			return "es un método; cambiarlo puede dejar de cumplir una interfaz"
+		}
+	case *types.Var:
+		if o.IsField() {
+			if o.Embedded() {
+				return "es un campo embebido; depende del nombre del tipo"
+			}
+			if o.Exported() {
+				return "es un campo exportado; cambiaría la salida de JSON y otros codificadores"
+			}
+			return fieldConflict(pkg, o, name)
+		}
+	case *types.TypeName:
+		if embedded(pkg, o) {
+			return "el tipo está embebido en un struct; el campo también tendría que cambiar"
+		}
+	}
+
+	scope := obj.Parent()
+	if scope == nil {
+		return "no se encontró su ámbito"
+	}
+	if existing := scope.Lookup(name); existing != nil || taken[scope][name] {
+		return fmt.Sprintf("ya existe %q en el mismo ámbito", name)
+	}
+	// Tampoco puede capturar usos de otro objeto con el nombre nuevo
+	// declarado más afuera, ej. una variable global o una función importada
+	for id, other := range pkg.Info.Uses {
+		if id.Name != name || other == obj || other.Parent() == nil {
+			continue
+		}
+		if encloses(scope, pkg.Types.Scope().Innermost(id.Pos())) && encloses(other.Parent(), scope) {
+			p := pkg.Fset.Position(id.Pos())
+			return fmt.Sprintf("ocultaría a %q, que se usa en la línea %d", name, p.Line)
+		}
+	}
+	// Cada uso debe seguir resolviendo al mismo objeto: otro identificador
+	// con el nombre nuevo en un ámbito interior lo ocultaría
+	for _, id := range uses {
+		inner := pkg.Types.Scope().Innermost(id.Pos())
+		if inner == nil {
+			continue
+		}
+		if _, found := inner.LookupParent(name, id.Pos()); found != nil && found != obj {
+			p := pkg.Fset.Position(found.Pos())
+			return fmt.Sprintf("%q quedaría oculto por otra declaración en la línea %d", name, p.Line)
+		}
+	}
+	return ""
+}
+
+// fieldConflict revisa que el struct no tenga ya un campo o método con el
+// nombre nuevo.
+func fieldConflict(pkg *loader.Package, field *types.Var, name string) string {
+	for _, obj := range pkg.Info.Defs {
+		tn, ok := obj.(*types.TypeName)
+		if !ok {
+			continue
+		}
+		st, ok := tn.Type().Underlying().(*types.Struct)
+		if !ok {
+			continue
+		}
+		for i := range st.NumFields() {
+			if st.Field(i) != field {
+				continue
+			}
+			if o, _, _ := types.LookupFieldOrMethod(tn.Type(), true, pkg.Types, name); o != nil {
+				return fmt.Sprintf("%s ya tiene un campo o método %q", tn.Name(), name)
+			}
+			return ""
+		}
+	}
+	return ""
+}
+
+// embedded indica si tn aparece embebido en algún struct del paquete.
+func embedded(pkg *loader.Package, tn *types.TypeName) bool {
+	for _, obj := range pkg.Info.Defs {
+		v, ok := obj.(*types.Var)
+		if !ok || !v.Embedded() {
+			continue
+		}
+		t := v.Type()
+		if p, ok := t.(*types.Pointer); ok {
+			t = p.Elem()
+		}
+		if named, ok := t.(*types.Named); ok && named.Obj() == tn {
+			return true
+		}
+	}
+	return false
+}
+
+// encloses indica si inner es outer o está dentro de él.
+func encloses(outer, inner *types.Scope) bool {
+	for s := inner; s != nil; s = s.Parent() {
+		if s == outer {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,284 @@
+// Package naming revisa que los identificadores sigan las convenciones de
+// 04_naming_conventions y, si se pide, los renombra en todos sus usos.
+package naming
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/FepDev25/gobootcamp/02_basics/04_naming_conventions/casing"
+	"github.com/FepDev25/gobootcamp/internal/loader"
+)
+
+// Rule es una de las reglas de nombres.
+type Rule string
+
+const (
+	// Initialisms: las siglas van en un solo caso, employeeID y no employeeId.
+	Initialisms Rule = "initialisms"
+	// Underscores: nada de snake_case, textoNum y no texto_num.
+	Underscores Rule = "underscores"
+	// AllCaps: constantes y variables sin MAYÚSCULAS: maxRetries. Los nombres
+	// de hasta tres letras sin guion bajo pueden ser siglas, como CSV u OK,
+	// y no se reportan.
+	AllCaps Rule = "allcaps"
+	// Exported: las variables locales no se exportan, así que no empiezan
+	// con mayúscula.
+	Exported Rule = "exported"
+)
+
+// Rules son todas las reglas, en el orden en que se aplican. Cada
+// identificador recibe como mucho un hallazgo, el de la primera regla que
+// incumple.
+var Rules = []Rule{AllCaps, Underscores, Exported, Initialisms}
+
+// Config elige qué reglas se aplican.
+type Config struct {
+	Rules []Rule `json:"rules"`
+	// AllowCapsConstants acepta constantes en MAYÚSCULAS, como enseña la
+	// lección, aunque AllCaps esté activa para el resto.
+	AllowCapsConstants bool `json:"allow_caps_constants"`
+	// Ignore son nombres que nunca se reportan.
+	Ignore []string `json:"ignore"`
+}
+
+// DefaultConfig activa todas las reglas.
+func DefaultConfig() Config {
+	return Config{Rules: Rules}
+}
+
+// LoadConfig lee una configuración en JSON, ej.
+//
+//	{"rules": ["initialisms", "underscores"], "ignore": ["MAX_SIZE"]}
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	cfg := DefaultConfig()
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return cfg, cfg.validate()
+}
+
+// ParseRules convierte una lista separada por comas, ej. "initialisms,allcaps".
+func ParseRules(list string) ([]Rule, error) {
+	var rules []Rule
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		rules = append(rules, Rule(name))
+	}
+	return rules, Config{Rules: rules}.validate()
+}
+
+func (c Config) validate() error {
+	for _, r := range c.Rules {
+		known := false
+		for _, k := range Rules {
+			known = known || r == k
+		}
+		if !known {
+			return fmt.Errorf("regla desconocida %q", r)
+		}
+	}
+	return nil
+}
+
+func (c Config) has(r Rule) bool {
+	for _, x := range c.Rules {
+		if x == r {
+			return true
+		}
+	}
+	return false
+}
+
+// Finding es un identificador que incumple una regla.
+type Finding struct {
+	Pos       token.Position
+	Rule      Rule
+	Name      string
+	Suggested string // Vacío si no hay un nombre mejor evidente, ej. MAXRETRIES
+	Message   string
+
+	ident *ast.Ident
+	obj   types.Object
+}
+
+// Check revisa los identificadores declarados en pkg. Las posiciones son
+// relativas a base.
+func Check(pkg *loader.Package, base string, cfg Config) []Finding {
+	ignore := make(map[string]bool)
+	for _, name := range cfg.Ignore {
+		ignore[name] = true
+	}
+
+	var findings []Finding
+	for ident, obj := range pkg.Info.Defs {
+		if obj == nil || ident.Name == "_" || ignore[ident.Name] {
+			continue
+		}
+		if _, ok := obj.(*types.PkgName); ok {
+			continue
+		}
+		if fn, ok := obj.(*types.Func); ok && isSpecialFunc(fn) {
+			continue
+		}
+		f, ok := check(obj, cfg)
+		if !ok {
+			continue
+		}
+		f.Pos = pkg.Position(base, ident.Pos())
+		f.ident, f.obj = ident, obj
+		findings = append(findings, f)
+	}
+	sort.Slice(findings, func(i, j int) bool {
+		a, b := findings[i].Pos, findings[j].Pos
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		return a.Offset < b.Offset
+	})
+	return findings
+}
+
+// isSpecialFunc reconoce las funciones cuyo nombre lo fija el lenguaje o
+// go test, como TestFoo_Bar.
+func isSpecialFunc(fn *types.Func) bool {
+	for _, prefix := range []string{"Test", "Benchmark", "Example", "Fuzz"} {
+		if strings.HasPrefix(fn.Name(), prefix) && fn.Type().(*types.Signature).Recv() == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func check(obj types.Object, cfg Config) (Finding, bool) {
+	name := obj.Name()
+	_, isConst := obj.(*types.Const)
+	upperFirst := unicode.IsUpper([]rune(name)[0])
+	local := isLocal(obj)
+
+	// El nombre ideal mantiene si es exportado, salvo en las locales
+	style := casing.Camel
+	if upperFirst && !local {
+		style = casing.Pascal
+	}
+
+	f := Finding{Name: name}
+	switch {
+	case cfg.has(AllCaps) && isAllCaps(name) && (isConst || isVar(obj)) && !(isConst && cfg.AllowCapsConstants) &&
+		(strings.Contains(name, "_") || letterCount(name) > 3):
+		f.Rule = AllCaps
+		f.Message = fmt.Sprintf("%q está en MAYÚSCULAS; en Go se usa mixedCase o PascalCase, también en constantes", name)
+		if len(casing.Split(name)) > 1 {
+			f.Suggested = casing.Convert(name, style)
+		}
+	case cfg.has(Underscores) && strings.Contains(strings.Trim(name, "_"), "_") && !isAllCaps(name):
+		f.Rule = Underscores
+		f.Suggested = casing.Convert(name, style)
+		f.Message = fmt.Sprintf("%q usa snake_case; en Go se usa mixedCase o PascalCase", name)
+	case cfg.has(Exported) && upperFirst && local && !isConst && isVar(obj):
+		f.Rule = Exported
+		f.Suggested = casing.Convert(name, casing.Camel)
+		f.Message = fmt.Sprintf("%q es una variable local: no se puede exportar, así que va en minúscula", name)
+	case cfg.has(Initialisms) && !isAllCaps(name):
+		fixed := fixInitialisms(name)
+		if fixed == name {
+			return f, false
+		}
+		f.Rule = Initialisms
+		f.Suggested = fixed
+		f.Message = fmt.Sprintf("%q debería escribirse %q: las siglas van en un solo caso", name, fixed)
+	default:
+		return f, false
+	}
+	if f.Suggested == name {
+		f.Suggested = ""
+	}
+	return f, true
+}
+
+func isVar(obj types.Object) bool {
+	v, ok := obj.(*types.Var)
+	return ok && !v.IsField()
+}
+
+// isLocal indica si obj está declarado dentro de una función.
+func isLocal(obj types.Object) bool {
+	if obj.Pkg() == nil || obj.Parent() == nil {
+		return false // Campos y métodos no tienen ámbito propio
+	}
+	return obj.Parent() != obj.Pkg().Scope()
+}
+
+func letterCount(s string) int {
+	n := 0
+	for _, c := range s {
+		if unicode.IsLetter(c) {
+			n++
+		}
+	}
+	return n
+}
+
+// isAllCaps indica si name tiene más de una letra y todas son mayúsculas,
+// sin contar las siglas conocidas como ID o HTTPS.
+func isAllCaps(name string) bool {
+	letters := 0
+	for _, c := range name {
+		if unicode.IsLower(c) {
+			return false
+		}
+		if unicode.IsUpper(c) {
+			letters++
+		}
+	}
+	if letters <= 1 {
+		return false
+	}
+	for _, w := range casing.Split(name) {
+		if !casing.Initialisms[w] {
+			return true
+		}
+	}
+	return false
+}
+
+// fixInitialisms escribe cada sigla en un solo caso, sin tocar el resto del
+// nombre: employeeId → employeeID, urlPath queda igual.
+func fixInitialisms(name string) string {
+	words := casing.Split(name)
+	if strings.Join(words, "") != name {
+		return name // Tiene separadores; eso es de otra regla
+	}
+	for i, w := range words {
+		upper := strings.ToUpper(w)
+		plural := strings.HasSuffix(w, "s") && casing.Initialisms[strings.ToUpper(w[:len(w)-1])]
+		if !casing.Initialisms[upper] && !plural {
+			continue
+		}
+		// La primera palabra de un nombre en minúscula va toda en minúscula
+		if i == 0 && unicode.IsLower([]rune(w)[0]) {
+			words[i] = strings.ToLower(w)
+			continue
+		}
+		if plural {
+			words[i] = upper[:len(upper)-1] + "s"
+		} else {
+			words[i] = upper
+		}
+	}
+	return strings.Join(words, "")
+}
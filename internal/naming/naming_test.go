@@ -0,0 +1,144 @@
+package naming
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/FepDev25/gobootcamp/internal/loader"
+)
+
+func load(t *testing.T, dir string) *loader.Package {
+	t.Helper()
+	pkg, err := loader.LoadDirTests(filepath.Join("testdata", dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pkg
+}
+
+func TestFixInitialisms(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"employeeId", "employeeID"},
+		{"userUrl", "userURL"},
+		{"UrlPath", "URLPath"},
+		{"urlPath", "urlPath"},
+		{"httpsUrls", "httpsURLs"},
+		{"apiKey", "apiKey"},
+		{"ServeHttp", "ServeHTTP"},
+		{"texto_num", "texto_num"}, // Los guiones bajos son de otra regla
+	}
+	for _, tt := range tests {
+		if got := fixInitialisms(tt.in); got != tt.want {
+			t.Errorf("fixInitialisms(%q) = %q, se esperaba %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	pkg := load(t, "sample")
+	got := make(map[string]Finding)
+	for _, f := range Check(pkg, pkg.Dir, DefaultConfig()) {
+		got[f.Name] = f
+	}
+
+	want := []struct {
+		name      string
+		rule      Rule
+		suggested string
+	}{
+		{"MAX_SIZE", AllCaps, "MaxSize"},
+		{"employeeId", Initialisms, "employeeID"},
+		{"texto_num", Underscores, "textoNum"},
+		{"Local", Exported, "local"},
+		{"userUrl", Initialisms, "userURL"},
+	}
+	for _, w := range want {
+		f, ok := got[w.name]
+		if !ok {
+			t.Errorf("%s: no se reportó", w.name)
+			continue
+		}
+		if f.Rule != w.rule || f.Suggested != w.suggested {
+			t.Errorf("%s: [%s] %q, se esperaba [%s] %q", w.name, f.Rule, f.Suggested, w.rule, w.suggested)
+		}
+		delete(got, w.name)
+	}
+	for name, f := range got {
+		t.Errorf("%s: hallazgo inesperado [%s] %s", name, f.Rule, f.Message)
+	}
+}
+
+func TestCheckAllowCapsConstants(t *testing.T) {
+	pkg := load(t, "sample")
+	cfg := DefaultConfig()
+	cfg.AllowCapsConstants = true
+	for _, f := range Check(pkg, pkg.Dir, cfg) {
+		if f.Name == "MAX_SIZE" {
+			t.Errorf("MAX_SIZE se reportó con AllowCapsConstants: %s", f.Message)
+		}
+	}
+}
+
+func TestFix(t *testing.T) {
+	pkg := load(t, "sample")
+	files, renamed, skipped, err := Fix(pkg, Check(pkg, pkg.Dir, DefaultConfig()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(skipped) > 0 {
+		t.Errorf("se omitieron cambios: %+v", skipped)
+	}
+	uses := make(map[string]int)
+	for _, r := range renamed {
+		uses[r.Name] = r.Uses
+	}
+	// Un uso en main.go y dos en main_test.go
+	if uses["employeeId"] != 3 {
+		t.Errorf("employeeId: %d usos renombrados, se esperaban 3", uses["employeeId"])
+	}
+
+	test := string(files[filepath.Join(pkg.Dir, "main_test.go")])
+	if !strings.Contains(test, "employeeID != 1") || strings.Contains(test, "employeeId ") {
+		t.Errorf("main_test.go no se renombró:\n%s", test)
+	}
+	// Las funciones de prueba conservan su nombre
+	if !strings.Contains(test, "func TestEmployeeId(") {
+		t.Errorf("se renombró la función de prueba:\n%s", test)
+	}
+	main := string(files[filepath.Join(pkg.Dir, "main.go")])
+	for _, s := range []string{"textoNum := ", "local := ", "func userURL()", "const MaxSize = 10"} {
+		if !strings.Contains(main, s) {
+			t.Errorf("main.go no contiene %q:\n%s", s, main)
+		}
+	}
+}
+
+func TestFixSkipsConflicts(t *testing.T) {
+	pkg := load(t, "sample")
+	findings := Check(pkg, pkg.Dir, DefaultConfig())
+	// Sugerir un nombre que ya existe en el mismo ámbito
+	for i := range findings {
+		if findings[i].Name == "texto_num" {
+			findings[i].Suggested = "Local"
+		}
+	}
+	_, _, skipped, err := Fix(pkg, findings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(skipped) != 1 || skipped[0].Name != "texto_num" {
+		t.Fatalf("omitidos = %+v, se esperaba texto_num", skipped)
+	}
+}
+
+func TestFixRefusesTypeErrors(t *testing.T) {
+	pkg := load(t, "broken")
+	if len(pkg.TypeErrors) == 0 {
+		t.Fatal("testdata/broken debería tener errores de tipos")
+	}
+	files, _, _, err := Fix(pkg, Check(pkg, pkg.Dir, DefaultConfig()))
+	if err == nil || len(files) > 0 {
+		t.Errorf("Fix = %d archivos, %v; se esperaba un error", len(files), err)
+	}
+}
@@ -0,0 +1,3 @@
+package broken
+
+var employeeId int = "uno"
@@ -0,0 +1,18 @@
+package main
+
+import "fmt"
+
+const MAX_SIZE = 10
+
+var employeeId = 1
+
+func main() {
+	texto_num := "x"
+	Local := 2
+	fmt.Println(texto_num, Local, employeeId, MAX_SIZE, userUrl())
+}
+
+func userUrl() string {
+	url := "u"
+	return url
+}
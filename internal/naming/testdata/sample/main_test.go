@@ -0,0 +1,9 @@
+package main
+
+import "testing"
+
+func TestEmployeeId(t *testing.T) {
+	if employeeId != 1 {
+		t.Fatal(employeeId)
+	}
+}
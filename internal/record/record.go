@@ -0,0 +1,260 @@
+// Package record graba sesiones de una lección (entrada, salida y código de
+// salida) en formato asciicast v2 y las reproduce en la terminal.
+//
+// El formato es un encabezado JSON en la primera línea y un evento por
+// línea: [segundos, "o" | "i", "datos"]. La salida de error se graba como
+// "o" porque asciicast v2 no distingue stdout de stderr, y el código de
+// salida va en el campo "exit_code" del encabezado, que los reproductores
+// como asciinema ignoran.
+package record
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// Header es la primera línea de un archivo asciicast v2.
+type Header struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp,omitempty"`
+	Duration  float64           `json:"duration,omitempty"`
+	Command   string            `json:"command,omitempty"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+	ExitCode  *int              `json:"exit_code,omitempty"`
+}
+
+// Tipos de evento.
+const (
+	Output = "o"
+	Input  = "i"
+)
+
+// Event es un fragmento de entrada o salida y el momento en que ocurrió.
+type Event struct {
+	Time float64 // Segundos desde el inicio de la sesión
+	Type string
+	Data string
+}
+
+// MarshalJSON escribe el evento como [tiempo, tipo, datos].
+func (e Event) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{e.Time, e.Type, e.Data})
+}
+
+// UnmarshalJSON lee un evento con la forma [tiempo, tipo, datos].
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 3 {
+		return fmt.Errorf("evento con %d elementos, se esperaban 3", len(raw))
+	}
+	if err := json.Unmarshal(raw[0], &e.Time); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw[1], &e.Type); err != nil {
+		return err
+	}
+	return json.Unmarshal(raw[2], &e.Data)
+}
+
+// Cast es una sesión grabada.
+type Cast struct {
+	Header Header
+	Events []Event
+}
+
+// Session configura una grabación. Como en exec.Cmd, los campos de E/S
+// pueden quedar en nil: sin Stdin el programa lee fin de archivo y sin
+// Stdout o Stderr la salida solo se graba.
+type Session struct {
+	Stdin  io.Reader // Teclado del estudiante
+	Stdout io.Writer // Donde se muestra la salida mientras se graba
+	Stderr io.Writer
+	Width  int
+	Height int
+	Title  string
+}
+
+// Record ejecuta cmd, reenvía la entrada y la salida y graba cada fragmento
+// con su tiempo. Que el programa termine con error no es un error de Record:
+// el código queda en Header.ExitCode.
+//
+// Si Stdin es una terminal en modo normal, el sistema entrega la entrada
+// línea por línea, así que cada evento "i" corresponde a una línea.
+func (s Session) Record(cmd *exec.Cmd) (*Cast, error) {
+	rec := &recorder{start: time.Now()}
+	cast := &Cast{Header: Header{
+		Version:   2,
+		Width:     s.Width,
+		Height:    s.Height,
+		Timestamp: rec.start.Unix(),
+		Command:   strings.Join(cmd.Args, " "),
+		Title:     s.Title,
+	}}
+
+	stdout, stderr, input := rec.stream(Output), rec.stream(Output), rec.stream(Input)
+	cmd.Stdout = io.MultiWriter(stdout, orDiscard(s.Stdout))
+	cmd.Stderr = io.MultiWriter(stderr, orDiscard(s.Stderr))
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	// La lectura del teclado puede quedar bloqueada después de que el
+	// programa termine, por eso no se espera a esta goroutine.
+	go func() {
+		defer stdin.Close()
+		if s.Stdin == nil {
+			return
+		}
+		buf := make([]byte, 1024)
+		for {
+			n, err := s.Stdin.Read(buf)
+			if n > 0 {
+				input.Write(buf[:n])
+				if _, werr := stdin.Write(buf[:n]); werr != nil {
+					return
+				}
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	err = cmd.Wait()
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		return nil, err
+	}
+	code := cmd.ProcessState.ExitCode()
+	cast.Header.ExitCode = &code
+	cast.Header.Duration = time.Since(rec.start).Seconds()
+
+	rec.mu.Lock()
+	for _, st := range []*stream{stdout, stderr, input} {
+		st.flush()
+	}
+	cast.Events = rec.events
+	rec.mu.Unlock()
+	return cast, nil
+}
+
+func orDiscard(w io.Writer) io.Writer {
+	if w == nil {
+		return io.Discard
+	}
+	return w
+}
+
+type recorder struct {
+	start  time.Time
+	mu     sync.Mutex
+	events []Event
+}
+
+// event agrega un evento. Se llama con r.mu tomado.
+func (r *recorder) event(typ string, data []byte) {
+	r.events = append(r.events, Event{Time: time.Since(r.start).Seconds(), Type: typ, Data: string(data)})
+}
+
+func (r *recorder) stream(typ string) *stream {
+	return &stream{rec: r, typ: typ}
+}
+
+// stream graba los fragmentos de una fuente. Un fragmento puede terminar a
+// mitad de un carácter UTF-8; esos bytes esperan al siguiente fragmento para
+// que el evento no quede con un carácter partido.
+type stream struct {
+	rec     *recorder
+	typ     string
+	pending []byte
+}
+
+func (s *stream) Write(p []byte) (int, error) {
+	s.rec.mu.Lock()
+	defer s.rec.mu.Unlock()
+
+	data := append(s.pending, p...)
+	cut := len(data) - incompleteSuffix(data)
+	if cut > 0 {
+		s.rec.event(s.typ, data[:cut])
+	}
+	s.pending = append([]byte(nil), data[cut:]...)
+	return len(p), nil
+}
+
+// flush graba lo que quedó pendiente, aunque no sea UTF-8 válido. Se llama
+// con rec.mu tomado.
+func (s *stream) flush() {
+	if len(s.pending) > 0 {
+		s.rec.event(s.typ, s.pending)
+		s.pending = nil
+	}
+}
+
+// incompleteSuffix devuelve cuántos bytes del final de p son el comienzo de
+// un carácter UTF-8 que todavía no llegó completo.
+func incompleteSuffix(p []byte) int {
+	for i := len(p) - 1; i >= 0 && i >= len(p)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(p[i]) {
+			if utf8.FullRune(p[i:]) {
+				return 0
+			}
+			return len(p) - i
+		}
+	}
+	return 0
+}
+
+// Encode escribe la sesión en formato asciicast v2.
+func (c *Cast) Encode(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(c.Header); err != nil {
+		return err
+	}
+	for _, e := range c.Events {
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// Read lee una sesión en formato asciicast v2.
+func Read(r io.Reader) (*Cast, error) {
+	dec := json.NewDecoder(r)
+	var c Cast
+	if err := dec.Decode(&c.Header); err != nil {
+		return nil, fmt.Errorf("encabezado inválido: %w", err)
+	}
+	if c.Header.Version != 2 {
+		return nil, fmt.Errorf("versión asciicast %d no soportada", c.Header.Version)
+	}
+	for dec.More() {
+		var e Event
+		if err := dec.Decode(&e); err != nil {
+			return nil, fmt.Errorf("evento %d: %w", len(c.Events)+1, err)
+		}
+		c.Events = append(c.Events, e)
+	}
+	return &c, nil
+}
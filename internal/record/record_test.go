@@ -0,0 +1,163 @@
+package record
+
+import (
+	"bytes"
+	"os/exec"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func shell(t *testing.T, script string) *exec.Cmd {
+	t.Helper()
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("no hay sh en este sistema")
+	}
+	return exec.Command("sh", "-c", script)
+}
+
+// joined concatena los datos de los eventos de un tipo.
+func joined(events []Event, typ string) string {
+	var b strings.Builder
+	for _, e := range events {
+		if e.Type == typ {
+			b.WriteString(e.Data)
+		}
+	}
+	return b.String()
+}
+
+func TestRecord(t *testing.T) {
+	cmd := shell(t, `read nombre; echo "hola $nombre"; echo aviso >&2; exit 3`)
+	var stdout, stderr bytes.Buffer
+	s := Session{
+		Stdin:  strings.NewReader("ñandú\n"),
+		Stdout: &stdout,
+		Stderr: &stderr,
+		Width:  80,
+		Height: 24,
+		Title:  "prueba",
+	}
+	cast, err := s.Record(cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := cast.Header
+	if h.Version != 2 || h.Width != 80 || h.Height != 24 || h.Title != "prueba" {
+		t.Errorf("encabezado %+v", h)
+	}
+	if want := strings.Join(cmd.Args, " "); h.Command != want {
+		t.Errorf("Command = %q, se esperaba %q", h.Command, want)
+	}
+	if h.ExitCode == nil || *h.ExitCode != 3 {
+		t.Errorf("ExitCode = %v, se esperaba 3", h.ExitCode)
+	}
+	if h.Timestamp == 0 || h.Duration <= 0 {
+		t.Errorf("Timestamp %d y Duration %v, se esperaban positivos", h.Timestamp, h.Duration)
+	}
+
+	if got := joined(cast.Events, Input); got != "ñandú\n" {
+		t.Errorf("entrada grabada %q, se esperaba %q", got, "ñandú\n")
+	}
+	// Las dos salidas van como "o"; el orden entre ellas depende del sistema
+	out := joined(cast.Events, Output)
+	if !strings.Contains(out, "hola ñandú\n") || !strings.Contains(out, "aviso\n") || len(out) != len("hola ñandú\naviso\n") {
+		t.Errorf("salida grabada %q", out)
+	}
+	if stdout.String() != "hola ñandú\n" || stderr.String() != "aviso\n" {
+		t.Errorf("se mostró %q y %q", stdout.String(), stderr.String())
+	}
+	for i := 1; i < len(cast.Events); i++ {
+		if cast.Events[i].Time < cast.Events[i-1].Time {
+			t.Errorf("evento %d antes que el anterior: %+v", i, cast.Events)
+		}
+	}
+
+	// Lo grabado sobrevive a Encode y Read
+	var buf bytes.Buffer
+	if err := cast.Encode(&buf); err != nil {
+		t.Fatal(err)
+	}
+	back, err := Read(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(back, cast) {
+		t.Errorf("Read(Encode(c)) = %+v, se esperaba %+v", back, cast)
+	}
+}
+
+func TestRecordNilStreams(t *testing.T) {
+	cast, err := Session{}.Record(shell(t, `cat; echo fin`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := joined(cast.Events, Output); got != "fin\n" {
+		t.Errorf("salida grabada %q, se esperaba \"fin\\n\"", got)
+	}
+	if got := joined(cast.Events, Input); got != "" {
+		t.Errorf("entrada grabada %q sin Stdin", got)
+	}
+	if *cast.Header.ExitCode != 0 {
+		t.Errorf("ExitCode = %d, se esperaba 0", *cast.Header.ExitCode)
+	}
+}
+
+func TestStreamKeepsRunesWhole(t *testing.T) {
+	text := "ñandú → 🐹 €"
+	// Parte el texto en cada posición posible, también a mitad de carácter
+	for cut := 0; cut <= len(text); cut++ {
+		rec := &recorder{}
+		st := rec.stream(Output)
+		st.Write([]byte(text[:cut]))
+		st.Write([]byte(text[cut:]))
+		st.flush()
+
+		var got strings.Builder
+		for _, e := range rec.events {
+			if !utf8.ValidString(e.Data) {
+				t.Errorf("corte en %d: evento %q con UTF-8 inválido", cut, e.Data)
+			}
+			got.WriteString(e.Data)
+		}
+		if got.String() != text {
+			t.Errorf("corte en %d: se grabó %q, se esperaba %q", cut, got.String(), text)
+		}
+	}
+}
+
+func TestStreamFlushesIncompleteRune(t *testing.T) {
+	rec := &recorder{}
+	st := rec.stream(Input)
+	st.Write([]byte("a\xe2\x82"))
+	if len(rec.events) != 1 || rec.events[0].Data != "a" {
+		t.Fatalf("eventos %+v, se esperaba solo \"a\"", rec.events)
+	}
+	st.flush()
+	if len(rec.events) != 2 || rec.events[1].Data != "\xe2\x82" {
+		t.Errorf("eventos %+v, se esperaba que flush grabara los bytes pendientes", rec.events)
+	}
+}
+
+func TestIncompleteSuffix(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int
+	}{
+		{"", 0},
+		{"abc", 0},
+		{"ñ", 0},
+		{"a\xc3", 1},
+		{"a\xe2\x82", 2},
+		{"\xf0\x9f\x90", 3},
+		{"a\xff", 0},     // Inválido pero completo: no vale la pena esperar
+		{"a\x82\x82", 0}, // Continuaciones sueltas
+	}
+	for _, tt := range tests {
+		if got := incompleteSuffix([]byte(tt.in)); got != tt.want {
+			t.Errorf("incompleteSuffix(%q) = %d, se esperaba %d", tt.in, got, tt.want)
+		}
+	}
+}
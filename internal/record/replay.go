@@ -0,0 +1,49 @@
+package record
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// Player reproduce una sesión grabada.
+type Player struct {
+	Speed   float64       // 2 reproduce al doble de velocidad; 0 equivale a 1
+	MaxIdle time.Duration // Pausa máxima entre eventos; 0 no limita
+}
+
+// Play escribe en w la sesión respetando los tiempos originales. La
+// entrada también se escribe, porque el eco de la terminal no pasa por el
+// programa grabado y no aparece en los eventos de salida.
+func (p Player) Play(ctx context.Context, c *Cast, w io.Writer) error {
+	speed := p.Speed
+	if speed <= 0 {
+		speed = 1
+	}
+
+	prev := 0.0
+	for _, e := range c.Events {
+		wait := time.Duration((e.Time - prev) / speed * float64(time.Second))
+		prev = e.Time
+		if p.MaxIdle > 0 && wait > p.MaxIdle {
+			wait = p.MaxIdle
+		}
+
+		if wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+
+		if e.Type == Output || e.Type == Input {
+			if _, err := io.WriteString(w, e.Data); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
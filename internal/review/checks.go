@@ -0,0 +1,330 @@
+package review
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"go/types"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"github.com/FepDev25/gobootcamp/internal/loader"
+	"github.com/FepDev25/gobootcamp/internal/naming"
+)
+
+// checkFormat compara el archivo con la salida de gofmt.
+func (r *Report) checkFormat(path string) error {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	formatted, err := format.Source(src)
+	if err != nil || bytes.Equal(src, formatted) {
+		// Los errores de sintaxis los reporta go vet
+		return nil
+	}
+
+	line := 1
+	for i := 0; i < len(src) && i < len(formatted) && src[i] == formatted[i]; i++ {
+		if src[i] == '\n' {
+			line++
+		}
+	}
+	r.add(Format, token.Position{Filename: r.rel(path), Line: line},
+		"el archivo no está formateado con gofmt; la primera diferencia está en esta línea")
+	return nil
+}
+
+var vetLine = regexp.MustCompile(`^(.+\.go):(\d+):(\d+): (.*)$`)
+
+// checkVet ejecuta go vet sobre los archivos del directorio.
+func (r *Report) checkVet(ctx context.Context, dir string, files []string) error {
+	cmd := exec.CommandContext(ctx, "go", append([]string{"vet"}, files...)...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*exec.ExitError); !ok {
+		return fmt.Errorf("go vet: %w", err)
+	}
+
+	sc := bufio.NewScanner(bytes.NewReader(out))
+	for sc.Scan() {
+		m := vetLine.FindStringSubmatch(sc.Text())
+		if m == nil {
+			continue
+		}
+		file := m[1]
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(dir, file)
+		}
+		line, _ := strconv.Atoi(m[2])
+		col, _ := strconv.Atoi(m[3])
+		r.add(Vet, token.Position{Filename: r.rel(file), Line: line, Column: col}, m[4])
+	}
+	return sc.Err()
+}
+
+// checkNaming aplica las reglas de 04_naming_conventions con el linter de
+// gobootcamp naming. Como en la lección, las constantes pueden escribirse en
+// MAYÚSCULAS.
+func (r *Report) checkNaming(pkg *loader.Package) {
+	cfg := naming.DefaultConfig()
+	cfg.AllowCapsConstants = true
+	for _, f := range naming.Check(pkg, r.Root, cfg) {
+		r.add(Naming, f.Pos, f.Message)
+	}
+}
+
+// checkComplexity calcula la complejidad ciclomática de cada función.
+func (r *Report) checkComplexity(pkg *loader.Package) {
+	for _, f := range pkg.Files {
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			name := fn.Name.Name
+			if fn.Recv != nil && len(fn.Recv.List) > 0 {
+				name = types.ExprString(fn.Recv.List[0].Type) + "." + name
+			}
+			fc := FuncComplexity{Name: name, Pos: pkg.Position(r.Root, fn.Pos()), Complexity: cyclomatic(fn)}
+			r.Functions = append(r.Functions, fc)
+			if fc.Complexity > r.Options.MaxComplexity {
+				r.add(Complexity, fc.Pos, fmt.Sprintf("%s tiene complejidad %d (máximo %d); conviene dividirla en funciones más pequeñas",
+					name, fc.Complexity, r.Options.MaxComplexity))
+			}
+		}
+	}
+}
+
+// cyclomatic cuenta los caminos independientes de una función: uno más
+// cada decisión (if, for, case, && y ||).
+func cyclomatic(fn *ast.FuncDecl) int {
+	n := 1
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.IfStmt, *ast.ForStmt, *ast.RangeStmt:
+			n++
+		case *ast.CaseClause:
+			if node.List != nil {
+				n++
+			}
+		case *ast.CommClause:
+			if node.Comm != nil {
+				n++
+			}
+		case *ast.BinaryExpr:
+			if node.Op == token.LAND || node.Op == token.LOR {
+				n++
+			}
+		}
+		return true
+	})
+	return n
+}
+
+var errorType = types.Universe.Lookup("error").Type()
+
+// Llamadas cuyo error se ignora por convención.
+var ignoredCalls = map[string]bool{
+	"fmt.Print": true, "fmt.Printf": true, "fmt.Println": true,
+	"fmt.Fprint": true, "fmt.Fprintf": true, "fmt.Fprintln": true,
+	"(*strings.Builder).WriteString": true, "(*strings.Builder).WriteByte": true,
+	"(*strings.Builder).WriteRune": true, "(*strings.Builder).Write": true,
+	"(*bytes.Buffer).WriteString": true, "(*bytes.Buffer).WriteByte": true,
+	"(*bytes.Buffer).WriteRune": true, "(*bytes.Buffer).Write": true,
+}
+
+// checkErrors busca llamadas cuyo error se ignora o se descarta con _.
+func (r *Report) checkErrors(pkg *loader.Package) {
+	for _, f := range pkg.Files {
+		ast.Inspect(f, func(node ast.Node) bool {
+			switch node := node.(type) {
+			case *ast.ExprStmt:
+				call, ok := node.X.(*ast.CallExpr)
+				if !ok || ignoredCalls[calleeName(pkg, call)] {
+					return true
+				}
+				if errorResult(pkg, call) >= 0 {
+					r.add(Errors, pkg.Position(r.Root, call.Pos()),
+						fmt.Sprintf("el error que devuelve %s no se revisa", types.ExprString(call.Fun)))
+				}
+			case *ast.AssignStmt:
+				if len(node.Rhs) != 1 {
+					return true
+				}
+				call, ok := node.Rhs[0].(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				i := errorResult(pkg, call)
+				if i >= 0 && i < len(node.Lhs) {
+					if id, ok := node.Lhs[i].(*ast.Ident); ok && id.Name == "_" {
+						r.add(Errors, pkg.Position(r.Root, id.Pos()),
+							fmt.Sprintf("el error que devuelve %s se descarta con _", types.ExprString(call.Fun)))
+					}
+				}
+			}
+			return true
+		})
+	}
+}
+
+// errorResult devuelve el índice del resultado de tipo error de la llamada,
+// o -1 si no devuelve un error.
+func errorResult(pkg *loader.Package, call *ast.CallExpr) int {
+	tv, ok := pkg.Info.Types[call]
+	if !ok || tv.Type == nil {
+		return -1
+	}
+	if tuple, ok := tv.Type.(*types.Tuple); ok {
+		for i := tuple.Len() - 1; i >= 0; i-- {
+			if types.Identical(tuple.At(i).Type(), errorType) {
+				return i
+			}
+		}
+		return -1
+	}
+	if types.Identical(tv.Type, errorType) {
+		return 0
+	}
+	return -1
+}
+
+// calleeName devuelve el nombre completo de la función llamada, ej.
+// "fmt.Println" o "(*strings.Builder).WriteString".
+func calleeName(pkg *loader.Package, call *ast.CallExpr) string {
+	var id *ast.Ident
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		id = fun
+	case *ast.SelectorExpr:
+		id = fun.Sel
+	default:
+		return ""
+	}
+	if fn, ok := pkg.Info.Uses[id].(*types.Func); ok {
+		return fn.FullName()
+	}
+	return ""
+}
+
+// checkBootcamp aplica los analizadores del bootcamp: errores sobrescritos
+// antes de revisarse, nombres que ocultan identificadores predeclarados y
+// defer dentro de bucles.
+func (r *Report) checkBootcamp(pkg *loader.Package) {
+	for ident, obj := range pkg.Info.Defs {
+		// Los campos y métodos no tienen alcance propio y no ocultan nada
+		if obj == nil || obj.Parent() == nil || obj.Parent() == types.Universe {
+			continue
+		}
+		if types.Universe.Lookup(ident.Name) != nil {
+			r.add(Bootcamp, pkg.Position(r.Root, ident.Pos()),
+				fmt.Sprintf("%q oculta el identificador predeclarado del mismo nombre", ident.Name))
+		}
+	}
+
+	for _, f := range pkg.Files {
+		ast.Inspect(f, func(node ast.Node) bool {
+			switch node := node.(type) {
+			case *ast.BlockStmt:
+				r.checkOverwrittenErrors(pkg, node.List)
+			case *ast.CaseClause:
+				r.checkOverwrittenErrors(pkg, node.Body)
+			case *ast.CommClause:
+				r.checkOverwrittenErrors(pkg, node.Body)
+			case *ast.ForStmt:
+				r.checkDeferInLoop(pkg, node.Body)
+			case *ast.RangeStmt:
+				r.checkDeferInLoop(pkg, node.Body)
+			}
+			return true
+		})
+	}
+}
+
+// checkOverwrittenErrors reporta variables de tipo error que se asignan dos
+// veces seguidas en el mismo bloque sin leerse entre ambas asignaciones.
+func (r *Report) checkOverwrittenErrors(pkg *loader.Package, stmts []ast.Stmt) {
+	pending := make(map[types.Object]token.Pos)
+
+	for _, stmt := range stmts {
+		assign, isAssign := stmt.(*ast.AssignStmt)
+
+		// Cualquier aparición de la variable fuera del lado izquierdo cuenta
+		// como revisión, para no reportar falsos positivos.
+		var reads []ast.Node
+		if isAssign {
+			for _, e := range assign.Rhs {
+				reads = append(reads, e)
+			}
+		} else {
+			reads = []ast.Node{stmt}
+		}
+		for _, n := range reads {
+			ast.Inspect(n, func(n ast.Node) bool {
+				if id, ok := n.(*ast.Ident); ok {
+					delete(pending, pkg.Info.Uses[id])
+				}
+				return true
+			})
+		}
+		if !isAssign {
+			continue
+		}
+
+		for _, lhs := range assign.Lhs {
+			id, ok := lhs.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			obj := pkg.Info.Defs[id]
+			if obj == nil {
+				obj = pkg.Info.Uses[id]
+			}
+			if obj == nil || !types.Identical(obj.Type(), errorType) {
+				continue
+			}
+			if prev, ok := pending[obj]; ok {
+				r.add(Bootcamp, pkg.Position(r.Root, prev),
+					fmt.Sprintf("el valor de %s se sobrescribe en la línea %d antes de revisarse",
+						id.Name, pkg.Fset.Position(id.Pos()).Line))
+			}
+			pending[obj] = id.Pos()
+		}
+	}
+}
+
+// checkDeferInLoop reporta los defer que se ejecutan recién al terminar la
+// función aunque estén dentro de un bucle.
+func (r *Report) checkDeferInLoop(pkg *loader.Package, body *ast.BlockStmt) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false // El defer pertenece a otra función
+		case *ast.ForStmt, *ast.RangeStmt:
+			return false // Los bucles anidados se revisan por separado
+		case *ast.DeferStmt:
+			r.add(Bootcamp, pkg.Position(r.Root, n.Pos()),
+				"defer dentro de un bucle: se ejecuta al terminar la función, no en cada iteración")
+		}
+		return true
+	})
+}
+
+// rel devuelve path relativo a la raíz de la entrega.
+func (r *Report) rel(path string) string {
+	if rel, err := filepath.Rel(r.Root, path); err == nil {
+		return filepath.ToSlash(rel)
+	}
+	return path
+}
@@ -0,0 +1,58 @@
+package review
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// maxFunctions es la cantidad de funciones listadas en la tabla de complejidad.
+const maxFunctions = 15
+
+// WriteMarkdown escribe el reporte de revisión en Markdown.
+func (r *Report) WriteMarkdown(w io.Writer, title string) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Revisión: %s\n\n", title)
+	fmt.Fprintf(&b, "**Puntaje total: %d/100**\n\n", r.Total())
+	b.WriteString("| Categoría | Hallazgos | Puntaje |\n|---|---:|---:|\n")
+	for _, s := range r.Scores() {
+		fmt.Fprintf(&b, "| %s | %d | %d |\n", s.Category, s.Findings, s.Points)
+	}
+
+	for _, c := range Categories {
+		fmt.Fprintf(&b, "\n## %s\n\n", c.Category)
+		if c.Category == Complexity {
+			r.writeFunctions(&b)
+		}
+		n := 0
+		for _, f := range r.Findings {
+			if f.Category != c.Category {
+				continue
+			}
+			fmt.Fprintf(&b, "- `%s:%d`: %s\n", f.Pos.Filename, f.Pos.Line, f.Message)
+			n++
+		}
+		if n == 0 {
+			b.WriteString("Sin observaciones.\n")
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (r *Report) writeFunctions(b *strings.Builder) {
+	if len(r.Functions) == 0 {
+		return
+	}
+	b.WriteString("| Función | Ubicación | Complejidad |\n|---|---|---:|\n")
+	for i, fc := range r.Functions {
+		if i == maxFunctions {
+			fmt.Fprintf(b, "| … %d funciones más | | |\n", len(r.Functions)-maxFunctions)
+			break
+		}
+		fmt.Fprintf(b, "| %s | `%s:%d` | %d |\n", fc.Name, fc.Pos.Filename, fc.Pos.Line, fc.Complexity)
+	}
+	b.WriteString("\n")
+}
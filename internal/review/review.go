@@ -0,0 +1,213 @@
+// Package review genera un reporte de revisión de código para una entrega:
+// formato, go vet, nombres, complejidad, errores sin revisar y los
+// analizadores propios del bootcamp.
+package review
+
+import (
+	"context"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/FepDev25/gobootcamp/internal/loader"
+)
+
+// Category agrupa los hallazgos de un mismo tipo de revisión.
+type Category string
+
+const (
+	Format     Category = "Formato (gofmt)"
+	Vet        Category = "go vet"
+	Naming     Category = "Nombres"
+	Complexity Category = "Complejidad ciclomática"
+	Errors     Category = "Errores sin revisar"
+	Bootcamp   Category = "Analizadores del bootcamp"
+)
+
+// Categories en el orden en que aparecen en el reporte, con los puntos que
+// resta cada hallazgo sobre 100.
+var Categories = []struct {
+	Category Category
+	Penalty  int
+}{
+	{Format, 20},
+	{Vet, 15},
+	{Naming, 5},
+	{Complexity, 10},
+	{Errors, 10},
+	{Bootcamp, 10},
+}
+
+// Finding es un comentario de revisión sobre una línea concreta.
+type Finding struct {
+	Category Category
+	Pos      token.Position // Filename relativo a la raíz de la entrega
+	Message  string
+}
+
+// FuncComplexity es la complejidad ciclomática de una función.
+type FuncComplexity struct {
+	Name       string
+	Pos        token.Position
+	Complexity int
+}
+
+// Options configura Run.
+type Options struct {
+	MaxComplexity int // Complejidad a partir de la cual se reporta una función
+}
+
+// Report contiene todos los hallazgos de una revisión.
+type Report struct {
+	Root      string
+	Findings  []Finding
+	Functions []FuncComplexity // Ordenadas de mayor a menor complejidad
+	Options   Options
+}
+
+// Run revisa todos los paquetes bajo root.
+func Run(ctx context.Context, root string, opts Options) (*Report, error) {
+	if opts.MaxComplexity <= 0 {
+		opts.MaxComplexity = 10
+	}
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	dirs, err := packageDirs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Report{Root: root, Options: opts}
+	for _, dir := range dirs {
+		if err := r.reviewDir(ctx, dir.path, dir.files); err != nil {
+			return nil, err
+		}
+	}
+
+	// Algunos hallazgos salen de mapas; con el orden completo el reporte es
+	// siempre el mismo
+	sort.Slice(r.Findings, func(i, j int) bool {
+		a, b := r.Findings[i], r.Findings[j]
+		switch {
+		case a.Pos.Filename != b.Pos.Filename:
+			return a.Pos.Filename < b.Pos.Filename
+		case a.Pos.Line != b.Pos.Line:
+			return a.Pos.Line < b.Pos.Line
+		case a.Pos.Column != b.Pos.Column:
+			return a.Pos.Column < b.Pos.Column
+		case a.Category != b.Category:
+			return a.Category < b.Category
+		}
+		return a.Message < b.Message
+	})
+	sort.SliceStable(r.Functions, func(i, j int) bool {
+		return r.Functions[i].Complexity > r.Functions[j].Complexity
+	})
+	return r, nil
+}
+
+func (r *Report) reviewDir(ctx context.Context, dir string, files []string) error {
+	var sources []string
+	for _, f := range files {
+		if err := r.checkFormat(filepath.Join(dir, f)); err != nil {
+			return err
+		}
+		if !strings.HasSuffix(f, "_test.go") {
+			sources = append(sources, f)
+		}
+	}
+	if err := r.checkVet(ctx, dir, files); err != nil {
+		return err
+	}
+	if len(sources) == 0 {
+		return nil
+	}
+
+	pkg, err := loader.Load(dir, sources)
+	if err != nil {
+		// Un error de sintaxis ya aparece en go vet; no hay AST que revisar
+		return nil
+	}
+	r.checkNaming(pkg)
+	r.checkComplexity(pkg)
+	r.checkErrors(pkg)
+	r.checkBootcamp(pkg)
+	return nil
+}
+
+func (r *Report) add(c Category, pos token.Position, msg string) {
+	r.Findings = append(r.Findings, Finding{Category: c, Pos: pos, Message: msg})
+}
+
+// Score es el puntaje de una categoría.
+type Score struct {
+	Category Category
+	Findings int
+	Points   int
+}
+
+// Scores calcula el puntaje sobre 100 de cada categoría.
+func (r *Report) Scores() []Score {
+	counts := make(map[Category]int)
+	for _, f := range r.Findings {
+		counts[f.Category]++
+	}
+	scores := make([]Score, 0, len(Categories))
+	for _, c := range Categories {
+		n := counts[c.Category]
+		scores = append(scores, Score{Category: c.Category, Findings: n, Points: max(0, 100-n*c.Penalty)})
+	}
+	return scores
+}
+
+// Total es el promedio de los puntajes de todas las categorías.
+func (r *Report) Total() int {
+	scores := r.Scores()
+	sum := 0
+	for _, s := range scores {
+		sum += s.Points
+	}
+	return sum / len(scores)
+}
+
+type pkgDir struct {
+	path  string
+	files []string
+}
+
+// packageDirs devuelve los directorios con archivos .go bajo root.
+func packageDirs(root string) ([]pkgDir, error) {
+	byDir := make(map[string][]string)
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := d.Name()
+		if d.IsDir() {
+			if path != root && (strings.HasPrefix(name, ".") || name == "testdata" || name == "vendor") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.HasSuffix(name, ".go") {
+			dir := filepath.Dir(path)
+			byDir[dir] = append(byDir[dir], name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dirs := make([]pkgDir, 0, len(byDir))
+	for path, files := range byDir {
+		sort.Strings(files)
+		dirs = append(dirs, pkgDir{path: path, files: files})
+	}
+	sort.Slice(dirs, func(i, j int) bool { return dirs[i].path < dirs[j].path })
+	return dirs, nil
+}
@@ -0,0 +1,50 @@
+package review
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/FepDev25/gobootcamp/internal/testutil"
+)
+
+func TestWriteMarkdown(t *testing.T) {
+	report, err := Run(context.Background(), filepath.Join("testdata", "entrega"), Options{MaxComplexity: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := report.WriteMarkdown(&buf, "entrega"); err != nil {
+		t.Fatal(err)
+	}
+	testutil.Golden(t, "entrega.golden", buf.Bytes())
+}
+
+func TestScores(t *testing.T) {
+	r := &Report{Findings: []Finding{{Category: Format}, {Category: Format}, {Category: Naming}}}
+	for _, s := range r.Scores() {
+		want := 100
+		switch s.Category {
+		case Format:
+			want = 60
+		case Naming:
+			want = 95
+		}
+		if s.Points != want {
+			t.Errorf("%s: %d puntos, se esperaba %d", s.Category, s.Points, want)
+		}
+	}
+	if got, want := r.Total(), (60+100+95+100+100+100)/6; got != want {
+		t.Errorf("Total() = %d, se esperaba %d", got, want)
+	}
+
+	// Los puntajes no bajan de cero
+	r = &Report{}
+	for range 10 {
+		r.Findings = append(r.Findings, Finding{Category: Format})
+	}
+	if s := r.Scores()[0]; s.Points != 0 {
+		t.Errorf("%s con 10 hallazgos: %d puntos, se esperaba 0", s.Category, s.Points)
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+const MAX_SIZE = 10
+
+func main() {
+	texto_num := "42"
+	n, _ := strconv.Atoi(texto_num)
+	fmt.Printf("%d\n", texto_num)
+
+	len, cap := n, n
+	fmt.Println(len, cap)
+
+	for _, name := range os.Args[1:] {
+		f, err := os.Open(name)
+		if err != nil {
+			continue
+		}
+		defer f.Close()
+	}
+
+	os.Remove("tmp.txt")
+
+	_, err := strconv.Atoi("1")
+	_, err = strconv.Atoi("2")
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(classify(n))
+}
+
+func classify(n int) string {
+	switch {
+	case n < 0:
+		return "negativo"
+	case n == 0:
+		return "cero"
+	case n < 10 && n%2 == 0:
+		return "par pequeño"
+	case n < 10:
+		return "impar pequeño"
+	}
+	return "grande"
+}
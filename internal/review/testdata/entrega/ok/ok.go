@@ -0,0 +1,13 @@
+// Package ok no tiene observaciones.
+package ok
+
+import "strconv"
+
+// Double duplica el número que recibe como texto.
+func Double(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+	return 2 * n, nil
+}
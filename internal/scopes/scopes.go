@@ -0,0 +1,235 @@
+// Package scopes arma el árbol de ámbitos de un paquete (universo, paquete,
+// archivo, función y bloques) con lo que se declara en cada uno, y detecta
+// las declaraciones que ocultan a otra de un ámbito exterior.
+package scopes
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+
+	"github.com/FepDev25/gobootcamp/internal/loader"
+)
+
+// Scope es un nodo del árbol.
+type Scope struct {
+	Kind     string // universo, paquete, archivo, función, if, for, bloque...
+	Name     string // Nombre de la función o del archivo, si tiene
+	Pos, End token.Position
+	Decls    []Decl
+	Children []*Scope
+}
+
+// Decl es una declaración dentro de un ámbito.
+type Decl struct {
+	Name    string
+	Kind    string // var, const, type, func, import o label
+	Type    string
+	Pos     token.Position
+	Shadows *Shadow // nil si no oculta nada
+}
+
+// Shadow describe la declaración exterior que queda oculta.
+type Shadow struct {
+	Name        string
+	Pos         token.Position // Vacía si es predeclarada
+	Predeclared bool
+}
+
+// Build arma el árbol de pkg. Si file no está vacío, solo incluye ese
+// archivo. Las posiciones son relativas a base.
+func Build(pkg *loader.Package, base, file string) *Scope {
+	b := &builder{pkg: pkg, base: base, nodes: make(map[*types.Scope]ast.Node), funcNames: make(map[ast.Node]string)}
+	for node, scope := range pkg.Info.Scopes {
+		b.nodes[scope] = node
+	}
+	for _, f := range pkg.Files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			if fd, ok := n.(*ast.FuncDecl); ok {
+				name := fd.Name.Name
+				if fd.Recv != nil && len(fd.Recv.List) > 0 {
+					name = types.ExprString(fd.Recv.List[0].Type) + "." + name
+				}
+				b.funcNames[fd.Type] = name
+			}
+			return true
+		})
+	}
+
+	universe := &Scope{Kind: "universo"}
+	for _, name := range types.Universe.Names() {
+		obj := types.Universe.Lookup(name)
+		universe.Decls = append(universe.Decls, Decl{Name: name, Kind: kindOf(obj), Type: b.typeString(obj)})
+	}
+
+	pkgScope := b.build(pkg.Types.Scope(), "paquete", pkg.Types.Name())
+	for i, f := range pkg.Files {
+		if file != "" && filepath.Base(pkg.Filenames[i]) != filepath.Base(file) {
+			continue
+		}
+		if s, ok := pkg.Info.Scopes[f]; ok {
+			pkgScope.Children = append(pkgScope.Children, b.build(s, "archivo", filepath.Base(pkg.Filenames[i])))
+		}
+	}
+	universe.Children = []*Scope{pkgScope}
+	return universe
+}
+
+type builder struct {
+	pkg       *loader.Package
+	base      string
+	nodes     map[*types.Scope]ast.Node
+	funcNames map[ast.Node]string
+}
+
+func (b *builder) build(s *types.Scope, kind, name string) *Scope {
+	out := &Scope{Kind: kind, Name: name}
+	if s.Pos().IsValid() {
+		out.Pos = b.pkg.Position(b.base, s.Pos())
+		out.End = b.pkg.Position(b.base, s.End())
+	}
+
+	seen := make(map[token.Pos]bool)
+	for _, n := range s.Names() {
+		obj := s.Lookup(n)
+		// Los case de un switch de tipos declaran una variable implícita
+		// cada uno en la misma posición; se muestra una sola vez
+		if seen[obj.Pos()] && obj.Pos().IsValid() {
+			continue
+		}
+		seen[obj.Pos()] = true
+		d := Decl{Name: n, Kind: kindOf(obj), Type: b.typeString(obj), Pos: b.pkg.Position(b.base, obj.Pos())}
+		d.Shadows = b.shadowed(s, obj)
+		out.Decls = append(out.Decls, d)
+	}
+	sort.Slice(out.Decls, func(i, j int) bool { return out.Decls[i].Pos.Offset < out.Decls[j].Pos.Offset })
+
+	for i := range s.NumChildren() {
+		child := s.Child(i)
+		if kind == "paquete" {
+			continue // Los archivos se agregan aparte, para poder filtrarlos
+		}
+		k, n := b.describe(child)
+		c := b.build(child, k, n)
+		// Un bloque vacío (el cuerpo de un if sin declaraciones, por ejemplo)
+		// solo agrega ruido al árbol
+		if k == "bloque" && len(c.Decls) == 0 && len(c.Children) == 0 {
+			continue
+		}
+		out.Children = append(out.Children, c)
+	}
+	return out
+}
+
+// shadowed busca, desde el ámbito exterior a s, otra declaración con el
+// mismo nombre visible donde se declara obj.
+func (b *builder) shadowed(s *types.Scope, obj types.Object) *Shadow {
+	if obj.Name() == "_" || s.Parent() == nil {
+		return nil
+	}
+	_, outer := s.Parent().LookupParent(obj.Name(), obj.Pos())
+	if outer == nil {
+		return nil
+	}
+	if outer.Parent() == types.Universe {
+		return &Shadow{Name: outer.Name(), Predeclared: true}
+	}
+	return &Shadow{Name: outer.Name(), Pos: b.pkg.Position(b.base, outer.Pos())}
+}
+
+// describe devuelve el tipo de ámbito según el nodo que lo crea.
+func (b *builder) describe(s *types.Scope) (kind, name string) {
+	switch n := b.nodes[s].(type) {
+	case *ast.FuncType:
+		if name, ok := b.funcNames[n]; ok {
+			return "función", name
+		}
+		return "función literal", ""
+	case *ast.IfStmt:
+		return "if", ""
+	case *ast.ForStmt:
+		return "for", ""
+	case *ast.RangeStmt:
+		return "for range", ""
+	case *ast.SwitchStmt:
+		return "switch", ""
+	case *ast.TypeSwitchStmt:
+		return "switch de tipos", ""
+	case *ast.CaseClause:
+		return "case", ""
+	case *ast.CommClause:
+		return "case de select", ""
+	case *ast.File:
+		return "archivo", ""
+	}
+	return "bloque", ""
+}
+
+func kindOf(obj types.Object) string {
+	switch obj.(type) {
+	case *types.Var:
+		return "var"
+	case *types.Const:
+		return "const"
+	case *types.TypeName:
+		return "type"
+	case *types.Func, *types.Builtin:
+		return "func"
+	case *types.PkgName:
+		return "import"
+	case *types.Label:
+		return "label"
+	case *types.Nil:
+		return "nil"
+	}
+	return "?"
+}
+
+func (b *builder) typeString(obj types.Object) string {
+	switch o := obj.(type) {
+	case *types.PkgName:
+		return `"` + o.Imported().Path() + `"`
+	case *types.Builtin, *types.Nil, *types.Label:
+		return ""
+	case *types.TypeName:
+		return types.TypeString(o.Type().Underlying(), b.qualifier)
+	}
+	if obj.Type() == nil {
+		return ""
+	}
+	return types.TypeString(obj.Type(), b.qualifier)
+}
+
+func (b *builder) qualifier(p *types.Package) string {
+	if p == b.pkg.Types {
+		return ""
+	}
+	return p.Name()
+}
+
+// Shadows devuelve todas las declaraciones del árbol que ocultan a otra, en
+// el orden del código.
+func (s *Scope) Shadows() []Decl {
+	var out []Decl
+	var walk func(*Scope)
+	walk = func(s *Scope) {
+		for _, d := range s.Decls {
+			if d.Shadows != nil {
+				out = append(out, d)
+			}
+		}
+		for _, c := range s.Children {
+			walk(c)
+		}
+	}
+	walk(s)
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Pos.Filename != out[j].Pos.Filename {
+			return out[i].Pos.Filename < out[j].Pos.Filename
+		}
+		return out[i].Pos.Offset < out[j].Pos.Offset
+	})
+	return out
+}
@@ -0,0 +1,69 @@
+package scopes
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/FepDev25/gobootcamp/internal/loader"
+	"github.com/FepDev25/gobootcamp/internal/testutil"
+)
+
+func build(t *testing.T, file string) *Scope {
+	t.Helper()
+	pkg, err := loader.LoadDir(filepath.Join("testdata", "sample"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Build(pkg, pkg.Dir, file)
+}
+
+func TestWriteTree(t *testing.T) {
+	tests := []struct{ file, golden string }{
+		{"main.go", "main.golden"},
+		{"", "package.golden"},
+	}
+	for _, tt := range tests {
+		tree := build(t, tt.file)
+		if tree.Kind != "universo" || len(tree.Children) != 1 {
+			t.Fatalf("la raíz es %q con %d hijos, se esperaba el universo con el paquete", tree.Kind, len(tree.Children))
+		}
+		// Desde el paquete, porque el universo cambia entre versiones de Go
+		var buf bytes.Buffer
+		if err := tree.Children[0].WriteTree(&buf, false); err != nil {
+			t.Fatal(err)
+		}
+		testutil.Golden(t, tt.golden, buf.Bytes())
+	}
+}
+
+func TestWriteShadows(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteShadows(&buf, build(t, "").Shadows()); err != nil {
+		t.Fatal(err)
+	}
+	testutil.Golden(t, "shadows.golden", buf.Bytes())
+}
+
+func TestUniverse(t *testing.T) {
+	tree := build(t, "main.go")
+	var buf bytes.Buffer
+	if err := tree.WriteTree(&buf, false); err != nil {
+		t.Fatal(err)
+	}
+	first, _, _ := strings.Cut(buf.String(), "\n")
+	if !strings.HasPrefix(first, "universo: ") || strings.Contains(buf.String(), "· func len") {
+		t.Errorf("sin -universe se esperaba el universo resumido, se obtuvo %q", first)
+	}
+
+	buf.Reset()
+	if err := tree.WriteTree(&buf, true); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"· type int int", "· func len", "· const true untyped bool", "· nil nil"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("con el universo falta %q en:\n%s", want, buf.String())
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+var count = 10
+
+func main() {
+	count := 1
+	fmt.Println(count, total())
+
+	if err := check(count); err != nil {
+		err := errors.Unwrap(err)
+		fmt.Println(err)
+	}
+
+	for i := range 3 {
+		i := i * 2
+		fmt.Println(i)
+	}
+
+	len := func(s string) int { return count }
+	fmt.Println(len("hola"))
+}
+
+func check(n int) error {
+	switch v := any(n).(type) {
+	case int:
+		if v < 0 {
+			return errors.New("negativo")
+		}
+	}
+	return nil
+}
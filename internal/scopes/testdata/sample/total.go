@@ -0,0 +1,11 @@
+package main
+
+type item struct{ price int }
+
+func total() int {
+	sum := 0
+	for _, it := range []item{{2}, {3}} {
+		sum += it.price
+	}
+	return sum + count
+}
@@ -0,0 +1,80 @@
+package scopes
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteTree escribe el árbol. El universo se resume en una línea salvo que
+// universe sea true.
+func (s *Scope) WriteTree(w io.Writer, universe bool) error {
+	var b strings.Builder
+	s.write(&b, "", "", universe)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (s *Scope) write(b *strings.Builder, prefix, childPrefix string, universe bool) {
+	b.WriteString(prefix + s.Kind)
+	if s.Name != "" {
+		b.WriteString(" " + s.Name)
+	}
+	if s.Pos.Line > 0 && s.Kind != "archivo" {
+		fmt.Fprintf(b, " (líneas %d–%d)", s.Pos.Line, s.End.Line)
+	}
+	if s.Kind == "universo" && !universe {
+		fmt.Fprintf(b, ": %d identificadores predeclarados", len(s.Decls))
+	}
+	b.WriteString("\n")
+
+	if s.Kind != "universo" || universe {
+		for _, d := range s.Decls {
+			bar := "│ "
+			if len(s.Children) == 0 {
+				bar = "  "
+			}
+			b.WriteString(childPrefix + bar + "· " + d.String())
+			if d.Shadows != nil {
+				b.WriteString("  ⚠ oculta " + d.Shadows.String())
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	for i, c := range s.Children {
+		branch, indent := "├── ", "│   "
+		if i == len(s.Children)-1 {
+			branch, indent = "└── ", "    "
+		}
+		c.write(b, childPrefix+branch, childPrefix+indent, universe)
+	}
+}
+
+func (d Decl) String() string {
+	s := d.Kind + " " + d.Name
+	if d.Type != "" {
+		s += " " + d.Type
+	}
+	if d.Pos.Line > 0 {
+		s += fmt.Sprintf(" (línea %d)", d.Pos.Line)
+	}
+	return s
+}
+
+func (s *Shadow) String() string {
+	if s.Predeclared {
+		return "al identificador predeclarado " + s.Name
+	}
+	return fmt.Sprintf("a %s de %s", s.Name, s.Pos)
+}
+
+// WriteShadows escribe una línea por cada declaración que oculta a otra.
+func WriteShadows(w io.Writer, decls []Decl) error {
+	for _, d := range decls {
+		if _, err := fmt.Fprintf(w, "%s: %s %q oculta %s\n", d.Pos, d.Kind, d.Name, d.Shadows); err != nil {
+			return err
+		}
+	}
+	return nil
+}
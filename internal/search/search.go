@@ -0,0 +1,256 @@
+// Package search indexa el contenido del curso (la teoría en Markdown y el
+// código de las lecciones) y lo consulta con ranking TF-IDF.
+//
+// Cada línea es un documento: los resultados apuntan al archivo y la línea
+// exactos donde aparece el término.
+package search
+
+import (
+	"errors"
+	"go/scanner"
+	"go/token"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/FepDev25/gobootcamp/internal/lessons"
+)
+
+// Kind indica de dónde proviene el texto de una línea indexada.
+type Kind string
+
+const (
+	Theory Kind = "teoría"
+	Code   Kind = "código"
+)
+
+type document struct {
+	file  int // Índice en Index.files
+	line  int
+	kind  Kind
+	terms int // Cantidad de términos, para normalizar
+}
+
+type posting struct {
+	doc int
+	tf  int
+}
+
+type file struct {
+	path  string // Relativa a la raíz
+	lines []string
+}
+
+// Index es un índice invertido del contenido del curso.
+type Index struct {
+	files    []file
+	docs     []document
+	postings map[string][]posting
+}
+
+// Hit es un resultado de búsqueda.
+type Hit struct {
+	File    string
+	Line    int
+	Kind    Kind
+	Score   float64
+	Snippet []string // Líneas alrededor del resultado
+	First   int      // Número de línea de Snippet[0]
+}
+
+// codeDir es la parte del curso cuyo código se indexa; 01_hello_world y
+// 03_project quedan fuera.
+const codeDir = "02_basics"
+
+// Build indexa la teoría de 00_theory y el código de las lecciones de
+// 02_basics.
+func Build(root string) (*Index, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	idx := &Index{postings: make(map[string][]posting)}
+
+	theory := filepath.Join(root, "00_theory")
+	err = filepath.WalkDir(theory, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Ext(path) != ".md" {
+			return err
+		}
+		return idx.addMarkdown(root, path)
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	found, err := lessons.Find(root)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range found {
+		if !strings.HasPrefix(l.Name, codeDir+"/") {
+			continue
+		}
+		for _, path := range l.Paths() {
+			if err := idx.addGo(root, path); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return idx, nil
+}
+
+func (idx *Index) addFile(root, path string) (int, []byte, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return 0, nil, err
+	}
+	idx.files = append(idx.files, file{path: filepath.ToSlash(rel), lines: strings.Split(string(src), "\n")})
+	return len(idx.files) - 1, src, nil
+}
+
+func (idx *Index) addMarkdown(root, path string) error {
+	fi, _, err := idx.addFile(root, path)
+	if err != nil {
+		return err
+	}
+	for i, line := range idx.files[fi].lines {
+		idx.addDoc(fi, i+1, Theory, Tokenize(line))
+	}
+	return nil
+}
+
+// addGo indexa los identificadores, comentarios y cadenas de un archivo Go.
+// Las palabras clave y los operadores no aportan a la búsqueda.
+func (idx *Index) addGo(root, path string) error {
+	fi, src, err := idx.addFile(root, path)
+	if err != nil {
+		return err
+	}
+
+	fset := token.NewFileSet()
+	tf := fset.AddFile(path, -1, len(src))
+	var s scanner.Scanner
+	s.Init(tf, src, nil, scanner.ScanComments)
+
+	byLine := make(map[int][]string)
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		line := tf.Line(pos)
+		switch tok {
+		case token.IDENT, token.COMMENT:
+			byLine[line] = append(byLine[line], Tokenize(lit)...)
+		case token.STRING:
+			if text, err := strconv.Unquote(lit); err == nil {
+				lit = text
+			}
+			byLine[line] = append(byLine[line], Tokenize(lit)...)
+		}
+	}
+
+	lines := make([]int, 0, len(byLine))
+	for line := range byLine {
+		lines = append(lines, line)
+	}
+	sort.Ints(lines)
+	for _, line := range lines {
+		idx.addDoc(fi, line, Code, byLine[line])
+	}
+	return nil
+}
+
+func (idx *Index) addDoc(fi, line int, kind Kind, terms []string) {
+	if len(terms) == 0 {
+		return
+	}
+	id := len(idx.docs)
+	idx.docs = append(idx.docs, document{file: fi, line: line, kind: kind, terms: len(terms)})
+
+	counts := make(map[string]int)
+	for _, t := range terms {
+		counts[t]++
+	}
+	for t, n := range counts {
+		idx.postings[t] = append(idx.postings[t], posting{doc: id, tf: n})
+	}
+}
+
+// Search devuelve hasta limit resultados ordenados por relevancia. context
+// es la cantidad de líneas que se muestran antes y después de cada resultado;
+// un valor negativo se trata como 0.
+func (idx *Index) Search(query string, limit, context int) []Hit {
+	context = max(context, 0)
+	n := float64(len(idx.docs))
+	terms := uniq(Tokenize(query))
+	scores := make(map[int]float64)
+	matched := make(map[int]int)
+	for _, term := range terms {
+		list := idx.postings[term]
+		if len(list) == 0 {
+			continue
+		}
+		idf := math.Log(1 + n/float64(len(list)))
+		for _, p := range list {
+			tf := 1 + math.Log(float64(p.tf))
+			scores[p.doc] += tf * idf / (1 + math.Log(float64(idx.docs[p.doc].terms)))
+			matched[p.doc]++
+		}
+	}
+	// Las líneas que contienen más términos de la consulta van primero
+	for id := range scores {
+		scores[id] *= float64(matched[id]) / float64(len(terms))
+	}
+
+	ids := make([]int, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if scores[ids[i]] != scores[ids[j]] {
+			return scores[ids[i]] > scores[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	if limit > 0 && len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	hits := make([]Hit, 0, len(ids))
+	for _, id := range ids {
+		d := idx.docs[id]
+		f := idx.files[d.file]
+		first := max(1, d.line-context)
+		last := min(len(f.lines), d.line+context)
+		hits = append(hits, Hit{
+			File:    f.path,
+			Line:    d.line,
+			Kind:    d.kind,
+			Score:   scores[id],
+			Snippet: f.lines[first-1 : last],
+			First:   first,
+		})
+	}
+	return hits
+}
+
+func uniq(terms []string) []string {
+	seen := make(map[string]bool)
+	out := terms[:0]
+	for _, t := range terms {
+		if !seen[t] {
+			seen[t] = true
+			out = append(out, t)
+		}
+	}
+	return out
+}
@@ -0,0 +1,134 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"", nil},
+		// Tildes y mayúsculas
+		{"Función", []string{"funcion"}},
+		{"ÁRBOL árbol arbol", []string{"arbol", "arbol", "arbol"}},
+		{"año", []string{"ano"}},
+		{"pingüino", []string{"pinguin"}},
+		// Palabras vacías y de una letra
+		{"el mapa y la clave de x", []string{"mapa", "clav"}},
+		// Raíz: singular, plural, masculino y femenino
+		{"funciones función", []string{"funcion", "funcion"}},
+		{"mapas mapa", []string{"mapa", "mapa"}},
+		{"ordenado ordenada ordenados", []string{"ordenad", "ordenad", "ordenad"}},
+		{"is", nil},
+		// camelCase y snake_case: el término completo y sus partes
+		{"myMap", []string{"mymap", "my", "map"}},
+		{"ParseFloat", []string{"parsefloat", "pars", "float"}},
+		{"HTTPServer", []string{"httpserver", "http", "server"}},
+		{"texto_num", []string{"texto_num", "text", "num"}},
+		{"clear(m)", []string{"clear"}},
+		{"x1 := v2", []string{"x1", "v2"}},
+	}
+	for _, tt := range tests {
+		got := Tokenize(tt.in)
+		if len(got) == 0 && len(tt.want) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Tokenize(%q) = %q, se esperaba %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestBuildScope(t *testing.T) {
+	idx, err := Build("testdata/repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var files []string
+	for _, f := range idx.files {
+		files = append(files, f.path)
+	}
+	want := []string{"00_theory/mapas.md", "02_basics/01_lesson/main.go"}
+	if !reflect.DeepEqual(files, want) {
+		t.Errorf("archivos indexados %q, se esperaba %q", files, want)
+	}
+}
+
+func TestSearchRanking(t *testing.T) {
+	idx, err := Build("testdata/repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		query string
+		want  []int // Líneas de 00_theory/mapas.md en orden
+	}{
+		// La línea con los dos términos va primero
+		{"clear mapa", []int{3, 1, 4, 5}},
+		// Con un solo término, pesa más la línea corta que lo repite
+		{"mapas", []int{1, 4, 3, 5}},
+		// Sin tilde encuentra lo mismo que con tilde
+		{"funcion", []int{3}},
+		{"función", []int{3}},
+		{"inexistente", nil},
+	}
+	for _, tt := range tests {
+		var got []int
+		for _, h := range idx.Search(tt.query, 0, 0) {
+			if h.File != "00_theory/mapas.md" || h.Kind != Theory {
+				t.Errorf("Search(%q): resultado en %s (%s)", tt.query, h.File, h.Kind)
+				continue
+			}
+			got = append(got, h.Line)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Search(%q) = líneas %v, se esperaba %v", tt.query, got, tt.want)
+		}
+	}
+
+	hits := idx.Search("clear mapa", 2, 0)
+	if len(hits) != 2 || hits[0].Score < hits[1].Score {
+		t.Errorf("Search con límite 2 = %+v", hits)
+	}
+}
+
+func TestSearchCode(t *testing.T) {
+	idx, err := Build("testdata/repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hits := idx.Search("saludo", 0, 0)
+	if len(hits) != 1 {
+		t.Fatalf("Search(\"saludo\") = %+v, se esperaba solo el comentario de 02_basics", hits)
+	}
+	if h := hits[0]; h.File != "02_basics/01_lesson/main.go" || h.Line != 5 || h.Kind != Code {
+		t.Errorf("resultado %+v, se esperaba 02_basics/01_lesson/main.go:5", h)
+	}
+	// Las palabras clave no se indexan
+	if hits := idx.Search("func import package", 0, 0); len(hits) != 0 {
+		t.Errorf("palabras clave encontradas: %+v", hits)
+	}
+}
+
+func TestSearchContext(t *testing.T) {
+	idx, err := Build("testdata/repo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, context := range []int{-5, -1, 0, 1, 100} {
+		hits := idx.Search("saludo", 0, context)
+		if len(hits) == 0 {
+			t.Fatalf("-C %d: sin resultados", context)
+		}
+		h := hits[0]
+		if len(h.Snippet) == 0 || h.First > h.Line || h.First+len(h.Snippet) <= h.Line {
+			t.Errorf("-C %d: líneas %d..%d no incluyen la %d", context, h.First, h.First+len(h.Snippet)-1, h.Line)
+		}
+		if context <= 0 && len(h.Snippet) != 1 {
+			t.Errorf("-C %d: %d líneas de fragmento, se esperaba 1", context, len(h.Snippet))
+		}
+	}
+}
@@ -0,0 +1,8 @@
+package main
+
+import "fmt"
+
+// Saludo fuera de 02_basics: no se indexa
+func main() {
+	fmt.Println("saludo")
+}
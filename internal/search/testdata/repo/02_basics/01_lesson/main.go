@@ -0,0 +1,8 @@
+package main
+
+import "fmt"
+
+// Saludo imprime un saludo
+func main() {
+	fmt.Println("hola")
+}
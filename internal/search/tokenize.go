@@ -0,0 +1,100 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Palabras demasiado comunes para aportar al ranking.
+var stopWords = map[string]bool{
+	"a": true, "al": true, "con": true, "de": true, "del": true, "el": true,
+	"en": true, "es": true, "la": true, "las": true, "lo": true, "los": true,
+	"para": true, "por": true, "que": true, "se": true, "su": true, "un": true,
+	"una": true, "y": true, "o": true, "the": true, "of": true, "and": true,
+	"to": true, "in": true, "is": true, "an": true, "if": true,
+}
+
+// Equivalencias sin tilde, para que "función" y "funcion" coincidan.
+var folded = map[rune]rune{
+	'á': 'a', 'é': 'e', 'í': 'i', 'ó': 'o', 'ú': 'u', 'ü': 'u', 'ñ': 'n',
+	'à': 'a', 'è': 'e', 'ì': 'i', 'ò': 'o', 'ù': 'u',
+}
+
+// Tokenize divide un texto en términos normalizados: en minúsculas, sin
+// tildes, sin palabras vacías y con una raíz simple que iguala singular y
+// plural (funciones, función -> funcion). Los identificadores en camelCase
+// aportan el término completo y cada una de sus partes (myMap -> mymap,
+// my, map).
+func Tokenize(text string) []string {
+	var terms []string
+	add := func(word string) {
+		word = fold(word)
+		if len(word) > 1 && !stopWords[word] {
+			terms = append(terms, stem(word))
+		}
+	}
+
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+	for _, word := range words {
+		parts := splitIdentifier(word)
+		add(word)
+		if len(parts) > 1 {
+			for _, p := range parts {
+				add(p)
+			}
+		}
+	}
+	return terms
+}
+
+// fold pasa a minúsculas y quita las tildes.
+func fold(s string) string {
+	return strings.Map(func(r rune) rune {
+		r = unicode.ToLower(r)
+		if f, ok := folded[r]; ok {
+			return f
+		}
+		return r
+	}, s)
+}
+
+// splitIdentifier separa snake_case y camelCase: texto_num -> texto, num;
+// ParseFloat -> Parse, Float; HTTPServer -> HTTP, Server.
+func splitIdentifier(word string) []string {
+	var parts []string
+	for _, chunk := range strings.Split(word, "_") {
+		runes := []rune(chunk)
+		start := 0
+		for i := 1; i < len(runes); i++ {
+			prev, cur := runes[i-1], runes[i]
+			next := rune(0)
+			if i+1 < len(runes) {
+				next = runes[i+1]
+			}
+			lowerToUpper := unicode.IsLower(prev) && unicode.IsUpper(cur)
+			acronymEnd := unicode.IsUpper(prev) && unicode.IsUpper(cur) && unicode.IsLower(next)
+			if lowerToUpper || acronymEnd {
+				parts = append(parts, string(runes[start:i]))
+				start = i
+			}
+		}
+		if start < len(runes) {
+			parts = append(parts, string(runes[start:]))
+		}
+	}
+	return parts
+}
+
+// stem quita la "s" final y después una vocal final, para que las formas
+// en singular, plural, masculino y femenino compartan el mismo término.
+func stem(word string) string {
+	if len(word) > 3 && strings.HasSuffix(word, "s") {
+		word = word[:len(word)-1]
+	}
+	if len(word) > 4 && strings.ContainsAny(word[len(word)-1:], "aeo") {
+		word = word[:len(word)-1]
+	}
+	return word
+}
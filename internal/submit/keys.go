@@ -0,0 +1,111 @@
+package submit
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// DefaultKeyPath devuelve la ruta de la llave privada del estudiante,
+// ej. ~/.config/gobootcamp/ed25519.
+func DefaultKeyPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "gobootcamp", "ed25519"), nil
+}
+
+// GenerateKey crea un par de llaves ed25519. La privada se guarda en path y
+// la pública en path + ".pub", ambas en PEM. No sobrescribe llaves existentes.
+func GenerateKey(path string) (ed25519.PublicKey, error) {
+	if _, err := os.Stat(path); err == nil {
+		return nil, fmt.Errorf("la llave %s ya existe", path)
+	}
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	privDER, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		return nil, err
+	}
+	pubDER, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	privPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privDER})
+	if err := os.WriteFile(path, privPEM, 0o600); err != nil {
+		return nil, err
+	}
+	pubPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER})
+	if err := os.WriteFile(path+".pub", pubPEM, 0o644); err != nil {
+		return nil, err
+	}
+	return pub, nil
+}
+
+// LoadPrivateKey lee una llave privada generada por GenerateKey.
+func LoadPrivateKey(path string) (ed25519.PrivateKey, error) {
+	der, err := readPEM(path, "PRIVATE KEY")
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, err
+	}
+	priv, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%s no es una llave ed25519", path)
+	}
+	return priv, nil
+}
+
+// LoadPublicKey lee una llave pública generada por GenerateKey.
+func LoadPublicKey(path string) (ed25519.PublicKey, error) {
+	der, err := readPEM(path, "PUBLIC KEY")
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+	pub, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%s no es una llave ed25519", path)
+	}
+	return pub, nil
+}
+
+// Fingerprint devuelve la huella SHA-256 de una llave pública, útil para
+// que el instructor compare la llave de una entrega con la registrada.
+func Fingerprint(pub ed25519.PublicKey) string {
+	sum := sha256.Sum256(pub)
+	return "SHA256:" + hex.EncodeToString(sum[:])
+}
+
+func readPEM(path, blockType string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != blockType {
+		return nil, errors.New(path + ": no contiene un bloque " + blockType)
+	}
+	return block.Bytes, nil
+}
@@ -0,0 +1,239 @@
+// Package submit empaqueta las entregas de los estudiantes y permite al
+// instructor verificar su integridad.
+//
+// Una entrega es un .tar.gz con esta estructura:
+//
+//	manifest.json  hashes, versión de Go, fecha y resultados de las pruebas
+//	manifest.sig   firma ed25519 de manifest.json, en hexadecimal
+//	files/...      los archivos entregados
+package submit
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	manifestName  = "manifest.json"
+	signatureName = "manifest.sig"
+	filesPrefix   = "files/"
+
+	maxOutput = 4 << 10 // Bytes de salida de go test guardados por paquete
+)
+
+// Extensiones que se incluyen en la entrega; se omiten binarios y demás.
+var included = map[string]bool{
+	".go": true, ".mod": true, ".sum": true, ".md": true,
+	".txt": true, ".json": true, ".csv": true,
+}
+
+// Manifest describe el contenido de una entrega.
+type Manifest struct {
+	GoVersion string       `json:"go_version"`
+	CreatedAt time.Time    `json:"created_at"`
+	PublicKey []byte       `json:"public_key"`
+	Files     []File       `json:"files"`
+	Tests     []TestResult `json:"tests"`
+}
+
+// File es un archivo de la entrega con su hash.
+type File struct {
+	Path   string `json:"path"`
+	SHA256 string `json:"sha256"`
+	Size   int64  `json:"size"`
+}
+
+// TestResult es el resultado de go test en un directorio.
+type TestResult struct {
+	Dir    string `json:"dir"`
+	Passed bool   `json:"passed"`
+	Output string `json:"output"`
+}
+
+// Options configura Pack.
+type Options struct {
+	Root string             // Raíz del repositorio del estudiante
+	Dirs []string           // Directorios de ejercicios, relativos a Root
+	Key  ed25519.PrivateKey // Llave con la que se firma el manifiesto
+}
+
+// Pack recolecta los archivos, ejecuta las pruebas y escribe la entrega
+// firmada en w. Devuelve el manifiesto generado.
+func Pack(ctx context.Context, w io.Writer, opts Options) (*Manifest, error) {
+	files, err := collect(opts.Root, opts.Dirs)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no se encontraron archivos para entregar en %v", opts.Dirs)
+	}
+
+	goVersion, err := goEnv(ctx, "GOVERSION")
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Manifest{
+		GoVersion: goVersion,
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+		PublicKey: opts.Key.Public().(ed25519.PublicKey),
+		Files:     files,
+		Tests:     runTests(ctx, opts.Root, files),
+	}
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	sig := hex.EncodeToString(ed25519.Sign(opts.Key, data))
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	if err := writeEntry(tw, manifestName, data); err != nil {
+		return nil, err
+	}
+	if err := writeEntry(tw, signatureName, []byte(sig+"\n")); err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		content, err := os.ReadFile(filepath.Join(opts.Root, filepath.FromSlash(f.Path)))
+		if err != nil {
+			return nil, err
+		}
+		if err := writeEntry(tw, filesPrefix+f.Path, content); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	return m, gz.Close()
+}
+
+// collect devuelve los archivos de dirs ordenados por ruta. Siempre incluye
+// go.mod y go.sum de la raíz si existen, para poder compilar la entrega.
+func collect(root string, dirs []string) ([]File, error) {
+	seen := make(map[string]bool)
+	var files []File
+
+	add := func(rel string) error {
+		rel = filepath.ToSlash(rel)
+		if seen[rel] {
+			return nil
+		}
+		seen[rel] = true
+		data, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(rel)))
+		if err != nil {
+			return err
+		}
+		files = append(files, File{Path: rel, SHA256: hashOf(data), Size: int64(len(data))})
+		return nil
+	}
+
+	for _, name := range []string{"go.mod", "go.sum"} {
+		if _, err := os.Stat(filepath.Join(root, name)); err == nil {
+			if err := add(name); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, dir := range dirs {
+		base := filepath.Join(root, dir)
+		err := filepath.WalkDir(base, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if p != base && strings.HasPrefix(d.Name(), ".") {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if d.IsDir() || !d.Type().IsRegular() || !included[filepath.Ext(p)] {
+				return nil
+			}
+			rel, err := filepath.Rel(root, p)
+			if err != nil {
+				return err
+			}
+			if strings.HasPrefix(rel, "..") {
+				return fmt.Errorf("%s está fuera de la raíz %s", dir, root)
+			}
+			return add(rel)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+	return files, nil
+}
+
+// runTests ejecuta go test en cada directorio que contiene archivos .go.
+// Se pasan los archivos explícitamente para que funcione con o sin go.mod.
+func runTests(ctx context.Context, root string, files []File) []TestResult {
+	byDir := make(map[string][]string)
+	for _, f := range files {
+		if strings.HasSuffix(f.Path, ".go") {
+			dir := path.Dir(f.Path)
+			byDir[dir] = append(byDir[dir], path.Base(f.Path))
+		}
+	}
+
+	dirs := make([]string, 0, len(byDir))
+	for dir := range byDir {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	results := make([]TestResult, 0, len(dirs))
+	for _, dir := range dirs {
+		cmd := exec.CommandContext(ctx, "go", append([]string{"test", "-count=1"}, byDir[dir]...)...)
+		cmd.Dir = filepath.Join(root, filepath.FromSlash(dir))
+		output, err := cmd.CombinedOutput()
+		if len(output) > maxOutput {
+			output = output[len(output)-maxOutput:]
+		}
+		results = append(results, TestResult{Dir: dir, Passed: err == nil, Output: string(output)})
+	}
+	return results
+}
+
+func goEnv(ctx context.Context, key string) (string, error) {
+	out, err := exec.CommandContext(ctx, "go", "env", key).Output()
+	if err != nil {
+		return "", fmt.Errorf("go env %s: %w", key, err)
+	}
+	return string(bytes.TrimSpace(out)), nil
+}
+
+func writeEntry(tw *tar.Writer, name string, data []byte) error {
+	hdr := &tar.Header{Name: name, Mode: 0o644, Size: int64(len(data)), Typeflag: tar.TypeReg}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
+
+func hashOf(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,245 @@
+package submit
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// student crea un repositorio con un ejercicio que pasa sus pruebas.
+func student(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	files := map[string]string{
+		"go.mod":           "module alumno\n\ngo 1.21\n",
+		"ej1/sum.go":       "package ej1\n\nfunc Sum(a, b int) int { return a + b }\n",
+		"ej1/sum_test.go":  "package ej1\n\nimport \"testing\"\n\nfunc TestSum(t *testing.T) {\n\tif Sum(2, 3) != 5 {\n\t\tt.Fatal(\"Sum\")\n\t}\n}\n",
+		"ej1/notas.txt":    "hecho\n",
+		"ej1/binario.exe":  "no se entrega",
+		"ej1/.oculto/a.go": "package oculto\n",
+		"ej2/otro.go":      "package ej2\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+// newKey genera un par de llaves y las vuelve a leer del disco.
+func newKey(t *testing.T) ed25519.PrivateKey {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "ed25519")
+	pub, err := GenerateKey(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	priv, err := LoadPrivateKey(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadPublicKey(path + ".pub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !loaded.Equal(pub) || !priv.Public().(ed25519.PublicKey).Equal(pub) {
+		t.Fatal("las llaves leídas no coinciden con las generadas")
+	}
+	return priv
+}
+
+func pack(t *testing.T, key ed25519.PrivateKey) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	opts := Options{Root: student(t), Dirs: []string{"ej1"}, Key: key}
+	if _, err := Pack(context.Background(), &buf, opts); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// entry es un archivo de una entrega armada a mano.
+type entry struct{ name, data string }
+
+func archive(t *testing.T, entries ...entry) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, e := range entries {
+		if err := writeEntry(tw, e.name, []byte(e.data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// repack vuelve a armar una entrega después de pasar sus partes por edit.
+func repack(t *testing.T, data []byte, edit func(manifest, sig *string, files map[string][]byte)) []byte {
+	t.Helper()
+	m, sig, files, err := readArchive(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifest := string(m)
+	edit(&manifest, &sig, files)
+	entries := []entry{{manifestName, manifest}, {signatureName, sig}}
+	for name, content := range files {
+		entries = append(entries, entry{filesPrefix + name, string(content)})
+	}
+	return archive(t, entries...)
+}
+
+func TestPackVerify(t *testing.T) {
+	key := newKey(t)
+	data := pack(t, key)
+
+	dir := t.TempDir()
+	v, err := Verify(context.Background(), bytes.NewReader(data), VerifyOptions{
+		TrustedKey: key.Public().(ed25519.PublicKey),
+		RunTests:   true,
+		Dir:        dir,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !v.OK() {
+		t.Errorf("la entrega no se verificó: %+v", v)
+	}
+
+	var paths []string
+	for _, f := range v.Manifest.Files {
+		paths = append(paths, f.Path)
+	}
+	want := []string{"ej1/notas.txt", "ej1/sum.go", "ej1/sum_test.go", "go.mod"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("archivos %q, se esperaba %q", paths, want)
+	}
+	if len(v.Manifest.Tests) != 1 || !v.Manifest.Tests[0].Passed {
+		t.Errorf("pruebas al entregar %+v, se esperaba ej1 aprobado", v.Manifest.Tests)
+	}
+	if len(v.Tests) != 1 || !v.Tests[0].Passed {
+		t.Errorf("pruebas al verificar %+v, se esperaba ej1 aprobado", v.Tests)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "ej1", "sum.go")); err != nil {
+		t.Errorf("no se extrajo ej1/sum.go: %v", err)
+	}
+}
+
+func TestVerifyTampered(t *testing.T) {
+	key := newKey(t)
+	data := pack(t, key)
+
+	t.Run("manifiesto", func(t *testing.T) {
+		tampered := repack(t, data, func(m, sig *string, files map[string][]byte) {
+			if !strings.Contains(*m, `"passed": true`) {
+				t.Fatalf("manifiesto sin pruebas aprobadas:\n%s", *m)
+			}
+			*m = strings.Replace(*m, `"passed": true`, `"passed": false`, 1)
+		})
+		_, err := Verify(context.Background(), bytes.NewReader(tampered), VerifyOptions{})
+		if !errors.Is(err, ErrBadSignature) {
+			t.Errorf("error %v, se esperaba ErrBadSignature", err)
+		}
+	})
+
+	t.Run("firma", func(t *testing.T) {
+		tampered := repack(t, data, func(m, sig *string, files map[string][]byte) {
+			flipped := byte('0')
+			if (*sig)[0] == '0' {
+				flipped = '1'
+			}
+			*sig = string(flipped) + (*sig)[1:]
+		})
+		_, err := Verify(context.Background(), bytes.NewReader(tampered), VerifyOptions{})
+		if !errors.Is(err, ErrBadSignature) {
+			t.Errorf("error %v, se esperaba ErrBadSignature", err)
+		}
+	})
+
+	t.Run("archivos", func(t *testing.T) {
+		tampered := repack(t, data, func(m, sig *string, files map[string][]byte) {
+			files["ej1/sum.go"] = []byte("package ej1\n\nfunc Sum(a, b int) int { return 5 }\n")
+			delete(files, "ej1/notas.txt")
+			files["ej1/extra.go"] = []byte("package ej1\n")
+		})
+		v, err := Verify(context.Background(), bytes.NewReader(tampered), VerifyOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.OK() {
+			t.Error("OK() con archivos alterados")
+		}
+		if !reflect.DeepEqual(v.Mismatched, []string{"ej1/sum.go"}) {
+			t.Errorf("Mismatched = %q, se esperaba ej1/sum.go", v.Mismatched)
+		}
+		if !reflect.DeepEqual(v.Missing, []string{"ej1/notas.txt"}) {
+			t.Errorf("Missing = %q, se esperaba ej1/notas.txt", v.Missing)
+		}
+		if !reflect.DeepEqual(v.Extra, []string{"ej1/extra.go"}) {
+			t.Errorf("Extra = %q, se esperaba ej1/extra.go", v.Extra)
+		}
+	})
+
+	t.Run("otra llave", func(t *testing.T) {
+		other := newKey(t)
+		_, err := Verify(context.Background(), bytes.NewReader(data), VerifyOptions{
+			TrustedKey: other.Public().(ed25519.PublicKey),
+		})
+		if err == nil || errors.Is(err, ErrBadSignature) {
+			t.Errorf("error %v, se esperaba que rechazara la llave", err)
+		}
+	})
+}
+
+func TestReadArchiveRejectsTraversal(t *testing.T) {
+	for _, name := range []string{
+		"files/../fuera.go",
+		"files/ej1/../../fuera.go",
+		"files//etc/passwd",
+		"files/..",
+		"files/./ej1/a.go",
+	} {
+		data := archive(t, entry{manifestName, "{}"}, entry{signatureName, "00"}, entry{name, "package x\n"})
+		_, _, _, err := readArchive(bytes.NewReader(data))
+		if err == nil || !strings.Contains(err.Error(), "ruta inválida") {
+			t.Errorf("readArchive con %q: error %v, se esperaba ruta inválida", name, err)
+		}
+	}
+}
+
+func TestReadArchiveIncomplete(t *testing.T) {
+	data := archive(t, entry{manifestName, "{}"}, entry{filesPrefix + "a.go", "package a\n"})
+	if _, _, _, err := readArchive(bytes.NewReader(data)); err == nil {
+		t.Error("readArchive aceptó una entrega sin firma")
+	}
+}
+
+func TestGenerateKeyRefusesOverwrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ed25519")
+	if _, err := GenerateKey(path); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := GenerateKey(path); err == nil {
+		t.Error("GenerateKey sobrescribió una llave existente")
+	}
+}
@@ -0,0 +1,177 @@
+package submit
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ErrBadSignature indica que la firma no corresponde al manifiesto.
+var ErrBadSignature = errors.New("la firma del manifiesto no es válida")
+
+const maxEntrySize = 32 << 20 // Límite por archivo al extraer una entrega
+
+// VerifyOptions configura Verify.
+type VerifyOptions struct {
+	// TrustedKey, si no es nil, debe coincidir con la llave de la entrega.
+	TrustedKey ed25519.PublicKey
+	// RunTests vuelve a ejecutar las pruebas sobre los archivos extraídos.
+	RunTests bool
+	// Dir, si no está vacío, es donde se extraen los archivos verificados.
+	// Por defecto se usa un directorio temporal que se borra al terminar.
+	Dir string
+}
+
+// Verification es el resultado de revisar una entrega.
+type Verification struct {
+	Manifest    *Manifest
+	Fingerprint string
+	Mismatched  []string // Archivos cuyo hash no coincide
+	Missing     []string // Archivos del manifiesto que no vienen en el paquete
+	Extra       []string // Archivos del paquete que no están en el manifiesto
+	Tests       []TestResult
+	Regressions []string // Directorios que pasaban al entregar y ahora fallan
+}
+
+// OK indica si la entrega está íntegra y las pruebas no empeoraron.
+func (v *Verification) OK() bool {
+	return len(v.Mismatched) == 0 && len(v.Missing) == 0 &&
+		len(v.Extra) == 0 && len(v.Regressions) == 0
+}
+
+// Verify comprueba la firma y los hashes de la entrega leída de r. Una firma
+// inválida se reporta como ErrBadSignature, sin revisar el resto.
+func Verify(ctx context.Context, r io.Reader, opts VerifyOptions) (*Verification, error) {
+	tmp := opts.Dir
+	if tmp == "" {
+		var err error
+		tmp, err = os.MkdirTemp("", "gobootcamp-verify-")
+		if err != nil {
+			return nil, err
+		}
+		defer os.RemoveAll(tmp)
+	}
+
+	manifestData, sigHex, contents, err := readArchive(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var m Manifest
+	if err := json.Unmarshal(manifestData, &m); err != nil {
+		return nil, fmt.Errorf("manifiesto inválido: %w", err)
+	}
+	if len(m.PublicKey) != ed25519.PublicKeySize {
+		return nil, errors.New("el manifiesto no contiene una llave ed25519")
+	}
+	pub := ed25519.PublicKey(m.PublicKey)
+	if opts.TrustedKey != nil && !opts.TrustedKey.Equal(pub) {
+		return nil, fmt.Errorf("la entrega fue firmada con %s, no con la llave esperada %s",
+			Fingerprint(pub), Fingerprint(opts.TrustedKey))
+	}
+	sig, err := hex.DecodeString(strings.TrimSpace(sigHex))
+	if err != nil || !ed25519.Verify(pub, manifestData, sig) {
+		return nil, ErrBadSignature
+	}
+
+	v := &Verification{Manifest: &m, Fingerprint: Fingerprint(pub)}
+	for _, f := range m.Files {
+		data, ok := contents[f.Path]
+		if !ok {
+			v.Missing = append(v.Missing, f.Path)
+			continue
+		}
+		delete(contents, f.Path)
+		if hashOf(data) != f.SHA256 {
+			v.Mismatched = append(v.Mismatched, f.Path)
+			continue
+		}
+		dst := filepath.Join(tmp, filepath.FromSlash(f.Path))
+		if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(dst, data, 0o644); err != nil {
+			return nil, err
+		}
+	}
+	for name := range contents {
+		v.Extra = append(v.Extra, name)
+	}
+	sort.Strings(v.Extra)
+
+	if opts.RunTests && len(v.Mismatched) == 0 && len(v.Missing) == 0 {
+		v.Tests = runTests(ctx, tmp, m.Files)
+		recorded := make(map[string]bool)
+		for _, t := range m.Tests {
+			recorded[t.Dir] = t.Passed
+		}
+		for _, t := range v.Tests {
+			if recorded[t.Dir] && !t.Passed {
+				v.Regressions = append(v.Regressions, t.Dir)
+			}
+		}
+	}
+	return v, nil
+}
+
+// readArchive devuelve el manifiesto, la firma y los archivos de la entrega,
+// indexados por su ruta relativa.
+func readArchive(r io.Reader) (manifest []byte, sig string, files map[string][]byte, err error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	defer gz.Close()
+
+	files = make(map[string][]byte)
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, "", nil, err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		if hdr.Size > maxEntrySize {
+			return nil, "", nil, fmt.Errorf("%s es demasiado grande", hdr.Name)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, "", nil, err
+		}
+
+		switch {
+		case hdr.Name == manifestName:
+			manifest = data
+		case hdr.Name == signatureName:
+			sig = string(data)
+		case strings.HasPrefix(hdr.Name, filesPrefix):
+			name := strings.TrimPrefix(hdr.Name, filesPrefix)
+			// Rechaza rutas que escaparían del directorio de extracción
+			if clean := path.Clean(name); clean != name || path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+				return nil, "", nil, fmt.Errorf("ruta inválida en la entrega: %q", hdr.Name)
+			}
+			files[name] = data
+		}
+	}
+
+	if manifest == nil || sig == "" {
+		return nil, "", nil, errors.New("la entrega no contiene manifest.json y manifest.sig")
+	}
+	return manifest, sig, files, nil
+}
@@ -0,0 +1,31 @@
+// Package testutil reúne ayudas para las pruebas de los paquetes internos.
+package testutil
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "reescribe los archivos .golden con la salida actual")
+
+// Golden compara got con testdata/name; con -update lo reescribe.
+func Golden(t testing.TB, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("la salida no coincide con %s (usa -update para regenerarlo)\n--- obtenido:\n%s\n--- esperado:\n%s", path, got, want)
+	}
+}